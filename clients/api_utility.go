@@ -14,7 +14,9 @@ limitations under the License.
 package clients
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -93,6 +95,8 @@ type Params struct {
 
 // LastExecutionDetail is response returned by LastExecutionDetail job API
 type LastExecutionDetail struct {
+	Key                string    `json:"Key"`
+	IDBaseEntity       int64     `json:"IdBaseEntity"`
 	Value              string    `json:"Value"`
 	ExecutionHistoryID int       `json:"ExecutionHistoryId"`
 	JobStatus          JobStatus `json:"JobStatus"`
@@ -147,57 +151,84 @@ func (c *Client) RemoveSession() (*http.Response, error) {
 	return resp, err
 }
 
+// JobTracking - options of a job tracking
+type JobTracking struct {
+	// LastRun - run of the job before it was started again, the tracking waits for a run newer than LastRun.
+	// The current run of the job is tracked when it is nil.
+	LastRun *string
+	// Progress - called with every status change of the tracked run
+	Progress func(string)
+}
+
 // TrackJob - is used to track job status. It returns isJobCompleted, message
 func (c *Client) TrackJob(jobID int64, maxRetries int64, sleepInterval int64) (bool, string) {
-	var status bool
-	var message string
-	jobRetries := int64(0)
+	if err := c.TrackJobRun(context.Background(), jobID, maxRetries, sleepInterval, JobTracking{}); err != nil {
+		return false, err.Error()
+	}
+	return true, SuccessMsg
+}
+
+// TrackJobRun - polls the job till its run reaches a terminal state, it returns an error when the run fails, does not complete
+// or the context is cancelled. A run started at tracking.LastRun is the previous run of the job and is not tracked.
+func (c *Client) TrackJobRun(ctx context.Context, jobID int64, maxRetries int64, sleepInterval int64, tracking JobTracking) error {
 	api := fmt.Sprintf(JobAPI+"(%d)", jobID)
-	isJobCompleted := false
-	for jobRetries < maxRetries {
-		jobRetries++
-		time.Sleep(time.Second * time.Duration(sleepInterval))
+	lastStatus := ""
+	for jobRetries := int64(0); jobRetries < maxRetries; jobRetries++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second * time.Duration(sleepInterval)):
+		}
 		resp, err := c.Get(api, nil, nil)
 		if err != nil {
-			message = err.Error()
-			isJobCompleted = true
-			break
+			return err
 		}
-		if resp != nil {
-			jr := &JobResp{}
-			parseError := parseResponse(c, resp, &jr)
-			if parseError != nil {
-				return false, fmt.Sprintf("Unable to parse response from job %s", parseError)
+		jr := &JobResp{}
+		parseError := parseResponse(c, resp, &jr)
+		if parseError != nil {
+			return fmt.Errorf("unable to parse response from job: %w", parseError)
+		}
+		if tracking.LastRun != nil && jr.LastRun == *tracking.LastRun {
+			// the job has not started its new run yet
+			continue
+		}
+		if status := jr.LastRunStatus.Name; status != lastStatus {
+			lastStatus = status
+			if tracking.Progress != nil {
+				tracking.Progress(fmt.Sprintf("Job %d is %s", jobID, status))
 			}
-			lrs := jr.LastRunStatus.ID
-			if lrs == SuccessStatusID {
-				status = true
-				message = SuccessMsg
-				isJobCompleted = true
-				break
-			} else if findElementInArray(FailureStatusIDs, lrs) != -1 {
-				ledAPI := fmt.Sprintf(LastExecDetailAPI, jobID)
-				ledResp, err := c.Get(ledAPI, nil, nil)
-				isJobCompleted = true
-				if err != nil {
-					message = err.Error()
-				} else {
-					led := LastExecutionDetail{}
-					parseError := parseResponse(c, ledResp, &led)
-					if parseError != nil {
-						return false, fmt.Sprintf("Unable to parse response from job %s", parseError)
-					}
-					message = led.Value
-				}
-				break
+		}
+		lrs := jr.LastRunStatus.ID
+		if lrs == SuccessStatusID {
+			return nil
+		}
+		if findElementInArray(FailureStatusIDs, lrs) != -1 {
+			ledAPI := fmt.Sprintf(LastExecDetailAPI, jobID)
+			ledResp, err := c.Get(ledAPI, nil, nil)
+			if err != nil {
+				return err
+			}
+			led := LastExecutionDetail{}
+			parseError := parseResponse(c, ledResp, &led)
+			if parseError != nil {
+				return fmt.Errorf("unable to parse response from job: %w", parseError)
 			}
+			return errors.New(led.Value)
 		}
 	}
-	if !isJobCompleted {
-		message = fmt.Sprintf(JobIncompleteMsg, jobID, maxRetries)
-	}
+	return fmt.Errorf(JobIncompleteMsg, jobID, maxRetries)
+}
 
-	return status, message
+// RerunJob - runs the job again and waits for the new run, the job reports its previous run till the new run starts
+func (c *Client) RerunJob(ctx context.Context, jobID int64, maxRetries int64, sleepInterval int64, progress func(string)) error {
+	job, err := c.GetJob(jobID)
+	if err != nil {
+		return err
+	}
+	if err := c.RunJobs([]int64{jobID}); err != nil {
+		return err
+	}
+	return c.TrackJobRun(ctx, jobID, maxRetries, sleepInterval, JobTracking{LastRun: &job.LastRun, Progress: progress})
 }
 
 // GetJob - returns a job detail for job id
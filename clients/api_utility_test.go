@@ -14,6 +14,7 @@ limitations under the License.
 package clients
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"terraform-provider-ome/models"
@@ -143,6 +144,51 @@ func TestClient_TrackJob(t *testing.T) {
 	}
 }
 
+func TestClient_TrackJobRun(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+
+	c, _ := NewClient(opts)
+
+	// the completed previous run is skipped, the failure of the new run is returned
+	lastRun := "2024-01-01 10:00:00.000"
+	progress := []string{}
+	err := c.TrackJobRun(context.Background(), 67890, 5, 0, JobTracking{
+		LastRun:  &lastRun,
+		Progress: func(message string) { progress = append(progress, message) },
+	})
+	assert.EqualError(t, err, "LastExecutionDetail Failure of the new run")
+	assert.Equal(t, []string{"Job 67890 is Running", "Job 67890 is Failed"}, progress)
+
+	// without the last run, the previous run is tracked
+	err = c.TrackJobRun(context.Background(), 67890, 5, 0, JobTracking{})
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = c.TrackJobRun(ctx, 56789, 5, 1, JobTracking{})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestClient_RerunJob(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+
+	c, _ := NewClient(opts)
+
+	// the failure of the previous run reported after the job is run again is not taken for the new run
+	progress := []string{}
+	err := c.RerunJob(context.Background(), 67891, 5, 0, func(message string) { progress = append(progress, message) })
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Job 67891 is Running", "Job 67891 is Completed"}, progress)
+
+	assert.NotNil(t, c.RerunJob(context.Background(), -1, 5, 0, nil))
+}
+
 func TestGetURL(t *testing.T) {
 	https := "https"
 	host := "localhost"
@@ -0,0 +1,215 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"terraform-provider-ome/models"
+	"terraform-provider-ome/utils"
+	"unicode/utf16"
+)
+
+// ReadCatalogFile - reads and parses a Dell update catalog, plain or gzip compressed, from a local path or a file:// url.
+func ReadCatalogFile(location string) (models.DellCatalogManifest, error) {
+	path := location
+	if strings.Contains(location, "://") {
+		u, err := url.Parse(location)
+		if err != nil {
+			return models.DellCatalogManifest{}, err
+		}
+		if u.Scheme != "file" {
+			return models.DellCatalogManifest{}, fmt.Errorf(ErrCatalogLocation, location)
+		}
+		path = u.Path
+	}
+	data, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return models.DellCatalogManifest{}, err
+	}
+	return ParseCatalog(data)
+}
+
+// ParseCatalog - parses the content of a Dell update catalog, decompressing it when gzipped and decoding it when UTF-16 encoded.
+func ParseCatalog(data []byte) (models.DellCatalogManifest, error) {
+	manifest := models.DellCatalogManifest{}
+	if len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b {
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return manifest, err
+		}
+		defer reader.Close()
+		data, err = io.ReadAll(reader)
+		if err != nil {
+			return manifest, err
+		}
+	}
+	data = catalogUTF8(data)
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	// the content is UTF-8 at this point whatever the declared encoding is
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	if err := decoder.Decode(&manifest); err != nil {
+		return models.DellCatalogManifest{}, fmt.Errorf("unable to parse the catalog: %w", err)
+	}
+	return manifest, nil
+}
+
+// catalogUTF8 converts a UTF-16 catalog, as published by Dell, to UTF-8 using its byte order mark
+func catalogUTF8(data []byte) []byte {
+	if len(data) < 2 {
+		return data
+	}
+	var order binary.ByteOrder
+	switch {
+	case data[0] == 0xff && data[1] == 0xfe:
+		order = binary.LittleEndian
+	case data[0] == 0xfe && data[1] == 0xff:
+		order = binary.BigEndian
+	default:
+		return bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	}
+	units := make([]uint16, 0, len(data)/2)
+	for i := 2; i+1 < len(data); i += 2 {
+		units = append(units, order.Uint16(data[i:]))
+	}
+	return []byte(string(utf16.Decode(units)))
+}
+
+// CatalogBundles - returns the bundles of the catalog targeting any of the given system IDs or models, all bundles when none are given.
+func CatalogBundles(manifest models.DellCatalogManifest, systemIDs, modelNames []string) []models.CatalogBundleInfo {
+	bundles := []models.CatalogBundleInfo{}
+	for _, bundle := range manifest.Bundles {
+		bundleSystemIDs, bundleModels, ok := catalogSystems(bundle.TargetSystems, systemIDs, modelNames)
+		if !ok {
+			continue
+		}
+		packages := []string{}
+		for _, pkg := range bundle.Packages {
+			packages = append(packages, pkg.Path)
+		}
+		bundles = append(bundles, models.CatalogBundleInfo{
+			ReleaseID:   bundle.ReleaseID,
+			Name:        catalogDisplay(bundle.Name),
+			BundleType:  bundle.BundleType,
+			Version:     bundle.VendorVersion,
+			ReleaseDate: bundle.DateTime,
+			Path:        bundle.Path,
+			SystemIDs:   bundleSystemIDs,
+			Models:      bundleModels,
+			Packages:    packages,
+		})
+	}
+	return bundles
+}
+
+// CatalogComponents - returns the components of the catalog supporting any of the given system IDs or models, all components when none are given.
+func CatalogComponents(manifest models.DellCatalogManifest, systemIDs, modelNames []string) []models.CatalogComponentInfo {
+	components := []models.CatalogComponentInfo{}
+	for _, component := range manifest.Components {
+		componentSystemIDs, componentModels, ok := catalogSystems(component.SupportedSystems, systemIDs, modelNames)
+		if !ok {
+			continue
+		}
+		dependencies := []models.CatalogDependencyInfo{}
+		for _, dependency := range component.Dependencies {
+			dependencies = append(dependencies, models.CatalogDependencyInfo{
+				PackageID: dependency.PackageID,
+				Name:      firstDisplay(dependency.Display),
+				Version:   dependency.VendorVersion,
+			})
+		}
+		releaseDate := component.ReleaseDate
+		if releaseDate == "" {
+			releaseDate = component.DateTime
+		}
+		components = append(components, models.CatalogComponentInfo{
+			PackageID:      component.PackageID,
+			Name:           catalogDisplay(component.Name),
+			ComponentType:  component.ComponentType.Value,
+			Category:       catalogDisplay(component.Category),
+			Version:        component.VendorVersion,
+			DellVersion:    component.DellVersion,
+			Criticality:    CatalogCriticality(component.Criticality),
+			ReleaseDate:    releaseDate,
+			RebootRequired: strings.EqualFold(component.RebootRequired, "true"),
+			Path:           component.Path,
+			SystemIDs:      componentSystemIDs,
+			Models:         componentModels,
+			Dependencies:   dependencies,
+		})
+	}
+	return components
+}
+
+// CatalogCriticality - returns the criticality of a component, Optional, Recommended or Urgent.
+func CatalogCriticality(criticality models.DellCatalogDisplay) string {
+	// the display name reads like "Urgent-Dell highly recommends applying this update as soon as possible..."
+	if display := firstDisplay(criticality.Display); display != "" {
+		name, _, _ := strings.Cut(display, "-")
+		return strings.TrimSpace(name)
+	}
+	switch criticality.Value {
+	case "0":
+		return "Optional"
+	case "1":
+		return "Recommended"
+	case "2":
+		return "Urgent"
+	}
+	return criticality.Value
+}
+
+// catalogSystems returns the system IDs and models of the brands, and whether they match the filters
+func catalogSystems(brands []models.DellCatalogBrand, systemIDs, modelNames []string) ([]string, []string, bool) {
+	ids := []string{}
+	names := []string{}
+	matched := len(systemIDs) == 0 && len(modelNames) == 0
+	for _, brand := range brands {
+		for _, model := range brand.Models {
+			name := firstDisplay(model.Display)
+			ids = append(ids, model.SystemID)
+			names = append(names, name)
+			if utils.ContainsFold(systemIDs, model.SystemID) || utils.ContainsFold(modelNames, name) {
+				matched = true
+			}
+		}
+	}
+	return ids, names, matched
+}
+
+func catalogDisplay(display models.DellCatalogDisplay) string {
+	if name := firstDisplay(display.Display); name != "" {
+		return name
+	}
+	return display.Value
+}
+
+func firstDisplay(display []string) string {
+	for _, name := range display {
+		if name = strings.TrimSpace(name); name != "" {
+			return name
+		}
+	}
+	return ""
+}
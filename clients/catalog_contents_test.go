@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"compress/gzip"
+	_ "embed"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"terraform-provider-ome/models"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	//go:embed json_data/catalogContents.xml
+	catalogContents []byte
+)
+
+func writeCatalogFile(t *testing.T, name string, data []byte) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestClient_ReadCatalogFile(t *testing.T) {
+	var gzipped bytes.Buffer
+	writer := gzip.NewWriter(&gzipped)
+	_, _ = writer.Write(catalogContents)
+	_ = writer.Close()
+
+	utf16Catalog := []byte{0xff, 0xfe}
+	for _, unit := range utf16.Encode([]rune(string(catalogContents))) {
+		utf16Catalog = binary.LittleEndian.AppendUint16(utf16Catalog, unit)
+	}
+
+	xmlPath := writeCatalogFile(t, "Catalog.xml", catalogContents)
+	tests := []struct {
+		name     string
+		location string
+	}{
+		{"Plain catalog", xmlPath},
+		{"File url", "file://" + filepath.ToSlash(xmlPath)},
+		{"Gzipped catalog", writeCatalogFile(t, "Catalog.xml.gz", gzipped.Bytes())},
+		{"UTF-16 catalog", writeCatalogFile(t, "Catalog-utf16.xml", utf16Catalog)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest, err := ReadCatalogFile(tt.location)
+			assert.Nil(t, err)
+			assert.Equal(t, "24.03.00", manifest.Version)
+			assert.Equal(t, "downloads.dell.com", manifest.BaseLocation)
+			assert.Len(t, manifest.Bundles, 2)
+			assert.Len(t, manifest.Components, 2)
+			assert.Equal(t, "Dell Server BIOS PowerEdge R740/R740XD/R640/R940 Version 2.19.1", catalogDisplay(manifest.Components[0].Name))
+		})
+	}
+
+	_, err := ReadCatalogFile("https://downloads.dell.com/catalog/Catalog.xml.gz")
+	assert.ErrorContains(t, err, "only local paths and file:// urls are supported")
+	_, err = ReadCatalogFile(filepath.Join(t.TempDir(), "missing.xml"))
+	assert.NotNil(t, err)
+	_, err = ReadCatalogFile(writeCatalogFile(t, "invalid.xml", []byte("<Manifest>")))
+	assert.ErrorContains(t, err, "unable to parse the catalog")
+}
+
+func TestClient_CatalogContents(t *testing.T) {
+	manifest, err := ParseCatalog(catalogContents)
+	assert.Nil(t, err)
+
+	components := CatalogComponents(manifest, nil, nil)
+	assert.Len(t, components, 2)
+	assert.Equal(t, models.CatalogComponentInfo{
+		PackageID:      "V7HWN",
+		Name:           "Dell Server BIOS PowerEdge R740/R740XD/R640/R940 Version 2.19.1",
+		ComponentType:  "BIOS",
+		Category:       "BIOS",
+		Version:        "2.19.1",
+		DellVersion:    "2.19.1",
+		Criticality:    "Urgent",
+		ReleaseDate:    "August 10, 2023",
+		RebootRequired: true,
+		Path:           "FOLDER10581373M/1/BIOS_V7HWN_WN64_2.19.1.EXE",
+		SystemIDs:      []string{"0715", "0716"},
+		Models:         []string{"R740", "R640"},
+		Dependencies: []models.CatalogDependencyInfo{
+			{PackageID: "0DKF6", Name: "iDRAC with Lifecycle Controller", Version: "7.00.00.171"},
+		},
+	}, components[0])
+	assert.Equal(t, "Recommended", components[1].Criticality)
+	assert.False(t, components[1].RebootRequired)
+	assert.Empty(t, components[1].Dependencies)
+
+	components = CatalogComponents(manifest, []string{"0a6b"}, nil)
+	assert.Len(t, components, 1)
+	assert.Equal(t, "0DKF6", components[0].PackageID)
+	components = CatalogComponents(manifest, nil, []string{"r640"})
+	assert.Len(t, components, 1)
+	assert.Equal(t, "V7HWN", components[0].PackageID)
+	assert.Empty(t, CatalogComponents(manifest, []string{"FFFF"}, nil))
+
+	bundles := CatalogBundles(manifest, nil, []string{"R740"})
+	assert.Len(t, bundles, 1)
+	assert.Equal(t, "R0N0N", bundles[0].ReleaseID)
+	assert.Equal(t, "Dell Server Bundle for PowerEdge R740, Windows 64", bundles[0].Name)
+	assert.Equal(t, []string{"0715"}, bundles[0].SystemIDs)
+	assert.Len(t, bundles[0].Packages, 2)
+	assert.Len(t, CatalogBundles(manifest, nil, nil), 2)
+}
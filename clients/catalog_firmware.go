@@ -14,7 +14,10 @@ limitations under the License.
 package clients
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"terraform-provider-ome/models"
 )
 
@@ -97,3 +100,50 @@ func (c *Client) UpdateCatalogFirmware(id int64, payload models.CatalogsModel) (
 	err = c.JSONUnMarshal(bodyData, &returnVal)
 	return returnVal, err
 }
+
+// CheckRepositoryConnection - tests that OME can read the catalog file of the repository before the catalog is created or changed
+func (c *Client) CheckRepositoryConnection(payload models.CatalogsModel) error {
+	data, errMarshal := c.JSONMarshal(payload)
+	if errMarshal != nil {
+		return errMarshal
+	}
+	_, err := c.Post(RepositoryTestConnectionAPI, nil, data)
+	if err == nil {
+		return nil
+	}
+	var respErr *ResponseError
+	if !errors.As(err, &respErr) {
+		return err
+	}
+	catalogPath := payload.Filename
+	if payload.SourcePath != "" {
+		catalogPath = payload.SourcePath + "/" + payload.Filename
+	}
+	return fmt.Errorf(ErrRepositoryConnection, catalogPath, payload.Repository.RepositoryType, payload.Repository.Source, repositoryConnectionMessage(respErr))
+}
+
+// repositoryConnectionMessage returns the messages OME reports for the share, such as an unreachable host, rejected credentials or a missing file
+func repositoryConnectionMessage(respErr *ResponseError) string {
+	omeErr := models.RepositoryTestConnectionError{}
+	if err := json.Unmarshal([]byte(respErr.Body), &omeErr); err != nil {
+		return respErr.Error()
+	}
+	messages := []string{}
+	for _, info := range omeErr.Error.ExtendedInfo {
+		message := info.Message
+		if info.Resolution != "" {
+			message = message + " " + info.Resolution
+		}
+		if info.MessageID != "" {
+			message = fmt.Sprintf("%s (%s)", message, info.MessageID)
+		}
+		messages = append(messages, message)
+	}
+	if len(messages) == 0 {
+		if omeErr.Error.Message == "" {
+			return respErr.Error()
+		}
+		return omeErr.Error.Message
+	}
+	return strings.Join(messages, "; ")
+}
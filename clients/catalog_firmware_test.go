@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"terraform-provider-ome/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_CheckRepositoryConnection(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	catalog := func(source string) models.CatalogsModel {
+		return models.CatalogsModel{
+			Filename:   "Catalog.xml",
+			SourcePath: "catalogs",
+			Repository: models.RepositoryModel{
+				Name:           "catalog",
+				Source:         source,
+				RepositoryType: "NFS",
+			},
+		}
+	}
+
+	assert.Nil(t, c.CheckRepositoryConnection(catalog("nfs.example.com")))
+
+	err := c.CheckRepositoryConnection(catalog("unreachable.example.com"))
+	assert.EqualError(t, err, "the catalog catalogs/Catalog.xml can not be read from the NFS share unreachable.example.com: "+
+		"Unable to connect to the share because the share address is not reachable."+
+		" Make sure the share address is reachable from the appliance and retry the operation. (CUPD3105)")
+
+	err = c.CheckRepositoryConnection(catalog("no-json.example.com"))
+	assert.ErrorContains(t, err, "internal error")
+}
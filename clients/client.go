@@ -32,6 +32,25 @@ var (
 	ErrItemNotFound = fmt.Errorf("no items found, expecting one")
 )
 
+// ResponseError - error returned when OME responds with an unexpected status code
+type ResponseError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf(ErrResponseMsg, e.StatusCode, e.Body)
+}
+
+// IsNotFound returns true when the error reports that the requested object does not exist in OME
+func IsNotFound(err error) bool {
+	if errors.Is(err, ErrItemNotFound) {
+		return true
+	}
+	var respErr *ResponseError
+	return errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound
+}
+
 // Client type is to hold http client information
 type Client struct {
 	// httpclient from net/http
@@ -260,7 +279,7 @@ func (c *Client) DoRequest(request *http.Request) (*http.Response, error) {
 		if getBodyError != nil {
 			return nil, getBodyError
 		}
-		return response, fmt.Errorf(ErrResponseMsg, response.StatusCode, string(data))
+		return response, &ResponseError{StatusCode: response.StatusCode, Body: string(data)}
 	}
 
 	return response, err
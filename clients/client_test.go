@@ -350,6 +350,33 @@ func TestDoError(t *testing.T) {
 	}
 }
 
+// TestIsNotFound tests the not found detection on the errors returned by the client
+func TestIsNotFound(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/invalid":
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		_, _ = w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError"}}`))
+	}))
+	defer ts.Close()
+
+	c, _ := NewClient(initOptions(ts))
+
+	_, err := c.Get("/missing", nil, nil)
+	assert.True(t, IsNotFound(err))
+	assert.Contains(t, err.Error(), "status: 404")
+
+	_, err = c.Get("/invalid", nil, nil)
+	assert.NotNil(t, err)
+	assert.False(t, IsNotFound(err))
+
+	assert.True(t, IsNotFound(fmt.Errorf("uplink could not be found: %w", ErrItemNotFound)))
+	assert.False(t, IsNotFound(nil))
+}
+
 // TestDoRetry tests the timeout returned by the client
 func TestDoRetry(t *testing.T) {
 
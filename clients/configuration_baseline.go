@@ -14,9 +14,11 @@ limitations under the License.
 package clients
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"terraform-provider-ome/models"
+	"terraform-provider-ome/utils"
 )
 
 // CreateBaseline creates a baseline with baseline target devices and notification settings.
@@ -71,6 +73,29 @@ func (c *Client) DeleteBaseline(baselineIDs []int64) error {
 	return nil
 }
 
+// GetBaselineTargetDeviceIDs returns the ids of the devices targeted by the baseline, the groups targeted are expanded to their devices
+func (c *Client) GetBaselineTargetDeviceIDs(baseline models.OmeBaseline) ([]int64, error) {
+	deviceIDs := []int64{}
+	for _, target := range baseline.BaselineTargets {
+		if target.Type.Name != "GROUP" {
+			deviceIDs = append(deviceIDs, target.ID)
+			continue
+		}
+		devices, err := c.GetDevicesByGroupID(target.ID)
+		// a group deleted from OME no longer holds devices
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the devices of the group %d targeted by the baseline: %w", target.ID, err)
+		}
+		for _, device := range devices.Value {
+			deviceIDs = append(deviceIDs, device.ID)
+		}
+	}
+	return deviceIDs, nil
+}
+
 // GetBaselineByID gets the baseline details by baseline ID .
 func (c *Client) GetBaselineByID(id int64) (models.OmeBaseline, error) {
 	omeBaseline := models.OmeBaseline{}
@@ -96,6 +121,15 @@ func (c *Client) GetBaselineByName(name string) (models.OmeBaseline, error) {
 	return omeBaseline, nil
 }
 
+// GetAllBaselines gets all the configuration baselines.
+func (c *Client) GetAllBaselines() ([]models.OmeBaseline, error) {
+	omeBaselines := []models.OmeBaseline{}
+	err := c.GetValueWithPagination(RequestOptions{
+		URL: BaselineAPI,
+	}, &omeBaselines)
+	return omeBaselines, err
+}
+
 // GetBaselineDevComplianceReportsByID gets baseline device compliance report by baseline ID as string
 func (c *Client) GetBaselineDevComplianceReportsByID(baselineID int64) ([]models.OMEComplianceReports, error) {
 	cr := []models.OMEComplianceReports{}
@@ -119,6 +153,72 @@ func (c *Client) GetBaselineDevAttrComplianceReportsByID(baselineID int64, devic
 	return string(respData), err
 }
 
+// ParseDeviceComplianceDetail parses the device attribute compliance report returned by GetBaselineDevAttrComplianceReportsByID
+func ParseDeviceComplianceDetail(report string) (models.OMEDeviceComplianceDetail, error) {
+	detail := models.OMEDeviceComplianceDetail{}
+	err := json.Unmarshal([]byte(report), &detail)
+	return detail, err
+}
+
+// ComplianceStatusName returns the display name of a configuration compliance status
+func ComplianceStatusName(status int64) string {
+	switch status {
+	case ComplianceStatusCompliant:
+		return "Compliant"
+	case ComplianceStatusNonCompliant:
+		return "Non Compliant"
+	default:
+		return "Unknown"
+	}
+}
+
+// ComplianceComponents flattens the attribute groups of each component of the device compliance report.
+// The components can be filtered by name and the compliant attributes can be left out.
+func ComplianceComponents(detail models.OMEDeviceComplianceDetail, componentNames []string, nonCompliantOnly bool) []models.OMEComplianceComponent {
+	components := []models.OMEComplianceComponent{}
+	for _, group := range detail.ComplianceAttributeGroups {
+		if len(componentNames) > 0 && !utils.ContainsFold(componentNames, group.DisplayName) {
+			continue
+		}
+		component := models.OMEComplianceComponent{
+			Name:             group.DisplayName,
+			ComplianceStatus: group.ComplianceStatus,
+			ComplianceReason: group.ComplianceReason,
+			Attributes:       []models.OMEComplianceComponentAttribute{},
+		}
+		for _, attribute := range complianceGroupAttributes(group, "") {
+			if nonCompliantOnly && attribute.ComplianceStatus == ComplianceStatusCompliant {
+				continue
+			}
+			component.Attributes = append(component.Attributes, attribute)
+		}
+		if nonCompliantOnly && len(component.Attributes) == 0 && component.ComplianceStatus == ComplianceStatusCompliant {
+			continue
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// complianceGroupAttributes returns the attributes of the group and its sub groups, with the path of the sub group below the given group
+func complianceGroupAttributes(group models.OMEComplianceAttributeGroup, groupPath string) []models.OMEComplianceComponentAttribute {
+	attributes := []models.OMEComplianceComponentAttribute{}
+	for _, attribute := range group.Attributes {
+		attributes = append(attributes, models.OMEComplianceComponentAttribute{
+			Group:                  groupPath,
+			OMEComplianceAttribute: attribute,
+		})
+	}
+	for _, subGroup := range group.ComplianceSubAttributeGroups {
+		subGroupPath := subGroup.DisplayName
+		if groupPath != "" {
+			subGroupPath = groupPath + " / " + subGroup.DisplayName
+		}
+		attributes = append(attributes, complianceGroupAttributes(subGroup, subGroupPath)...)
+	}
+	return attributes
+}
+
 func (c *Client) getBaseline(url, name string) (models.OmeBaseline, error) {
 	omeBaselines := models.OmeBaselines{}
 	response, err := c.Get(url, nil, nil)
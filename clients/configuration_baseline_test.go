@@ -226,6 +226,25 @@ func TestClient_GetBaselineByID(t *testing.T) {
 	}
 }
 
+func TestClient_GetBaselineTargetDeviceIDs(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+
+	c, _ := NewClient(opts)
+
+	baseline := models.OmeBaseline{BaselineTargets: []models.BaselineTarget{
+		{ID: 1013, Type: models.BaselineTargetType{ID: 6000, Name: "GROUP"}},
+		{ID: 10339, Type: models.BaselineTargetType{ID: 1000, Name: "DEVICE"}},
+		// deleted group
+		{ID: 1099, Type: models.BaselineTargetType{ID: 6000, Name: "GROUP"}},
+	}}
+	deviceIDs, err := c.GetBaselineTargetDeviceIDs(baseline)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{10337, 10338, 10339}, deviceIDs)
+}
+
 func TestClient_GetBaselineDeviceComplianceReportByID(t *testing.T) {
 
 	ts := createNewTLSServer(t)
@@ -293,6 +312,43 @@ func TestClient_GetBaselineDeviceAttrComplianceReportByID(t *testing.T) {
 	}
 }
 
+func TestClient_ComplianceComponents(t *testing.T) {
+
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+
+	c, _ := NewClient(opts)
+
+	report, err := c.GetBaselineDevAttrComplianceReportsByID(14, 11803)
+	assert.Nil(t, err)
+	detail, err := ParseDeviceComplianceDetail(report)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(11803), detail.DeviceID)
+
+	components := ComplianceComponents(detail, nil, false)
+	assert.Equal(t, 1, len(components))
+	assert.Equal(t, "LifecycleController", components[0].Name)
+	assert.Equal(t, "Non Compliant", ComplianceStatusName(components[0].ComplianceStatus))
+	assert.Equal(t, 2, len(components[0].Attributes))
+	assert.Equal(t, "Lifecycle Controller Attributes", components[0].Attributes[0].Group)
+	assert.Equal(t, int64(721728), components[0].Attributes[0].AttributeID)
+	assert.Equal(t, "Disabled", components[0].Attributes[0].ExpectedValue)
+	assert.Equal(t, "", components[0].Attributes[0].Value)
+
+	components = ComplianceComponents(detail, []string{"lifecyclecontroller"}, true)
+	assert.Equal(t, 1, len(components))
+	assert.Equal(t, 1, len(components[0].Attributes))
+	assert.Equal(t, int64(721728), components[0].Attributes[0].AttributeID)
+
+	components = ComplianceComponents(detail, []string{"BIOS"}, false)
+	assert.Empty(t, components)
+
+	_, err = ParseDeviceComplianceDetail("invalid")
+	assert.NotNil(t, err)
+}
+
 func TestClient_GetBaselineByName(t *testing.T) {
 
 	ts := createNewTLSServer(t)
@@ -33,6 +33,30 @@ const (
 	ServiceTags = "servicetags"
 	//DeviceIDs - constant deviceids to identify the input
 	DeviceIDs = "deviceids"
+	//GroupNames - constant groupnames to identify the input
+	GroupNames = "groupnames"
+	//GroupIDs - constant groupids to identify the input
+	GroupIDs = "groupids"
+	// ComplianceStatusCompliant - configuration compliance status of a compliant device, component or attribute
+	ComplianceStatusCompliant = 1
+	// ComplianceStatusNonCompliant - configuration compliance status of a non compliant device, component or attribute
+	ComplianceStatusNonCompliant = 2
+	// FirmwareRulePin - component rule keeping a firmware component at a version
+	FirmwareRulePin = "Pin"
+	// FirmwareRuleExclude - component rule leaving a firmware component out of the baseline
+	FirmwareRuleExclude = "Exclude"
+	// FirmwareUpdateActionUpgrade - update action of a firmware component older than the baseline
+	FirmwareUpdateActionUpgrade = "UPGRADE"
+	// FirmwareUpdateActionDowngrade - update action of a firmware component newer than the baseline
+	FirmwareUpdateActionDowngrade = "DOWNGRADE"
+	// FirmwareUpdateActionEqual - update action of a firmware component at the baseline version
+	FirmwareUpdateActionEqual = "EQUAL"
+	// FirmwareUpdateActionExcluded - update action of a firmware component excluded by a component rule
+	FirmwareUpdateActionExcluded = "EXCLUDED"
+	// FirmwareGuardPolicyWarning - firmware compliance guard policy reporting the violations as warnings
+	FirmwareGuardPolicyWarning = "warning"
+	// FirmwareGuardPolicyError - firmware compliance guard policy reporting the violations as errors
+	FirmwareGuardPolicyError = "error"
 )
 
 // API's constants
@@ -53,6 +77,10 @@ const (
 	JobAPI = "/api/JobService/Jobs"
 	// IdentityPoolAPI - api used to manage IdentityPools
 	IdentityPoolAPI = "/api/IdentityPoolService/IdentityPools"
+	// IdentityPoolUsageSetsAPI - api used to get the identity sets in use of an IdentityPool
+	IdentityPoolUsageSetsAPI = IdentityPoolAPI + "(%d)/UsageIdentitySets"
+	// IdentityPoolUsageDetailsAPI - api used to get the identities in use of an identity set of an IdentityPool
+	IdentityPoolUsageDetailsAPI = IdentityPoolUsageSetsAPI + "(%d)/Details"
 	// UpdateNetworkConfigAPI - api used to update network configuration to the template
 	UpdateNetworkConfigAPI = "/api/TemplateService/Actions/TemplateService.UpdateNetworkConfig"
 	// LastExecDetailAPI - api used to get last execution details
@@ -65,6 +93,12 @@ const (
 	DeviceInventoryAPI = DeviceAPI + "(%d)/InventoryDetails"
 	//DeviceInventoryAPI - api for getting device inventory of a single type
 	DeviceInventorySingleAPI = DeviceInventoryAPI + "('%s')"
+	// ChassisSlotsInventoryType - inventory type listing the slots of a chassis
+	ChassisSlotsInventoryType = "chassisSlotsList"
+	// ComputeSlotType - slot type of a chassis slot holding a compute sled
+	ComputeSlotType = 2000
+	// ChassisDeviceType - device type of a chassis
+	ChassisDeviceType = 2000
 	// TemplateViewTypeAPI - api to get view type
 	TemplateViewTypeAPI = "/api/TemplateService/TemplateViewTypes"
 	// TemplateDeviceTypeAPI - api to get view type
@@ -83,6 +117,8 @@ const (
 	DeployAPI = "/api/TemplateService/Actions/TemplateService.Deploy"
 	//ProfileAPI - api to manage profiles
 	ProfileAPI = "/api/ProfileService/Profiles"
+	//AssignProfileAPI - api to assign a profile to a device or a chassis slot
+	AssignProfileAPI = "/api/ProfileService/Actions/ProfileService.AssignProfile"
 	//UnAssignProfileAPI - api to unassign profile
 	UnAssignProfileAPI = "/api/ProfileService/Actions/ProfileService.UnassignProfiles"
 	//DeleteProfileAPI - api to delete profile
@@ -99,6 +135,8 @@ const (
 	VlanNetworksAPI = "/api/NetworkConfigurationService/Networks"
 	//ImportTemplateAPI - api to import a template
 	ImportTemplateAPI = "/api/TemplateService/Actions/TemplateService.Import"
+	// ExportTemplateAPI - api to export the content of a template
+	ExportTemplateAPI = "/api/TemplateService/Actions/TemplateService.Export"
 	// TemplateNameContainsAPI - api to fetch templates by name
 	TemplateNameContainsAPI = "/api/TemplateService/Templates?$filter=contains(Name, '%s')"
 	//UserAPI - api to manage users
@@ -143,6 +181,12 @@ const (
 	FirmwareBaselineAPI = "/api/UpdateService/Baselines"
 	// RemoveFirmwareBaseline - api to remove firmware baseline
 	RemoveFirmwareBaseline = "/api/UpdateService/Actions/UpdateService.RemoveBaselines"
+	// RunJobsAPI - api to run existing jobs immediately
+	RunJobsAPI = "/api/JobService/Actions/JobService.RunJobs"
+	// RefreshCatalogsAPI - api to refresh firmware catalogs from their repository
+	RefreshCatalogsAPI = "/api/UpdateService/Actions/UpdateService.RefreshCatalogs"
+	// RepositoryTestConnectionAPI - api to test that a catalog repository share is reachable with the given credentials and path
+	RepositoryTestConnectionAPI = "/api/UpdateService/Actions/UpdateService.TestConnection"
 	// DeviceComplianceReportAPI gets the details of a specific compliance report
 	DeviceComplianceReportAPI = "/api/UpdateService/Actions/UpdateService.GetBaselinesReportByDeviceids"
 	FabricAPI                 = "/api/NetworkService/Fabrics"
@@ -169,6 +213,8 @@ const (
 	ErrEmptyDeviceDetails = "either Device ID or Servicetag is required"
 	// ErrInvalidFqdds = error message for invalid fqdds
 	ErrInvalidFqdds = "Invalid FQDDS for template creation"
+	// ErrInvalidTemplateContent = error message for invalid template content
+	ErrInvalidTemplateContent = "Invalid XML content for template creation"
 	// ErrInvalidTemplateViewType - error message for invalid template view type
 	ErrInvalidTemplateViewType = "Invalid template view type for template creation"
 	// SuccessMsg - job success message
@@ -209,6 +255,8 @@ const (
 	ErrCreateClient = "Unable to create client"
 	// ErrCreateSession - message returned when session creation fails
 	ErrCreateSession = "Unable to create OME session"
+	// ErrRemoveSession - message returned when session removal fails
+	ErrRemoveSession = "Unable to remove OME session"
 	// ErrImportDeployment - message returned when import deployment fails
 	ErrImportDeployment = "Unable to import deployment"
 	// ErrImportNoProfiles - message returned when import deployment fails for no existing profile
@@ -251,10 +299,16 @@ const (
 	ErrBaseLineReportInProgress = "inventory update is in progress, retry after some time"
 	// ErrBaseLineInvalidDevices - message returned when baseline has invalid devices
 	ErrBaseLineInvalidDevices = "devices %v are not part of a baseline"
+	// ErrBaseLineInvalidGroups - message returned when the baseline target groups are not found
+	ErrBaseLineInvalidGroups = "groups %v could not be found"
 	// ErrBaseLineInvalid - message returned when baseline name or id is invalid
 	ErrBaseLineInvalid = "either baseline name or id is required"
 	// ErrBaseLineUpdateRemediation - message returned when there is a error in baseline remediation for configuration
 	ErrBaseLineUpdateRemediation = "baseline configuration remediation update error"
+	// ErrInvalidCron - message returned when a cron expression is invalid
+	ErrInvalidCron = "invalid cron expression"
+	// ErrEnforceRunLater - message returned when the enforce mode of the configuration remediation is used with run_later
+	ErrEnforceRunLater = "enforce cannot be used with run_later, the remediation runs within the maintenance window instead"
 	// ErrBaseLineUpdateRemediation - message returned when baseline name or id is changed
 	ErrBaseLineModified = "baseline name or id cannot be modified"
 	// ErrBaseLineTargetsSize - message returned when min length is not satisfied
@@ -274,6 +328,10 @@ const (
 	ErrTemplateDeploymentRead = "unable to read template deployment resource"
 	// ErrTemplateDeploymentDelete - message returned when template deployment fails
 	ErrTemplateDeploymentDelete = "unable to delete template deployment resource"
+	// ErrTemplateDeploymentRolloutStopped - message returned when a batched template deployment exceeds its failure threshold
+	ErrTemplateDeploymentRolloutStopped = "template deployment rollout stopped"
+	// ErrInvalidSlotTarget - message returned when a chassis slot cannot be targeted by a template deployment
+	ErrInvalidSlotTarget = "invalid chassis slot target"
 	// ErrCreateTemplate - message returned when template creation fails
 	ErrCreateTemplate = "Unable to create template"
 	// ErrReadTemplate - message returned when template read fails
@@ -316,7 +374,34 @@ const (
 	ErrGnrUpdateVlanNetwork  = "error updating a vlan network"
 	ErrGnrDeleteVlanNetwork  = "error deleting a vlan network"
 	ErrGnrReadVlanNetwork    = "error reading a vlan network"
+	ErrImportVlanNetwork     = "error importing a vlan network"
 	ErrUpdateUplink          = "error updating uplink"
+	// ErrGnrDeviceAction - summary returned when failed to run an action on devices
+	ErrGnrDeviceAction = "error running the device action"
+	// ErrGnrComplianceCheck - summary returned when failed to run a baseline compliance check
+	ErrGnrComplianceCheck = "error running the baseline compliance check"
+	// ErrGnrCatalogRefresh - summary returned when failed to refresh firmware catalogs
+	ErrGnrCatalogRefresh = "error refreshing the firmware catalogs"
+	// ErrGnrExportTemplate - summary returned when failed to export a template
+	ErrGnrExportTemplate = "error exporting the template"
+	// ErrGnrTemplateDiff - summary returned when failed to compare templates
+	ErrGnrTemplateDiff = "error comparing the templates"
+	// ErrGnrDeploymentPrecheck - summary returned when failed to pre-check a template deployment
+	ErrGnrDeploymentPrecheck = "error running the deployment pre-check"
+	// ErrGnrDeviceIdentities - summary returned when failed to read the virtual identities of devices
+	ErrGnrDeviceIdentities = "error reading the device identities"
+	// ErrGnrCatalogContents - summary returned when failed to read the contents of a catalog file
+	ErrGnrCatalogContents = "error reading the catalog contents"
+	// ErrCatalogLocation - message returned when the catalog is not a local file
+	ErrCatalogLocation = "only local paths and file:// urls are supported, got %s"
+	// ErrRepositoryConnection - message returned when the repository of a catalog can not be used
+	ErrRepositoryConnection = "the catalog %s can not be read from the %s share %s: %s"
+	// ErrFirmwareComponentRule - message returned when a firmware component rule is invalid
+	ErrFirmwareComponentRule = "component rule %d is invalid: %s"
+	// ErrFirmwareComplianceGuard - message returned when targeted devices trip the firmware compliance guard
+	ErrFirmwareComplianceGuard = "targeted devices are %s or worse out of firmware compliance"
+	// ErrFirmwareComplianceGuardCheck - message returned when the firmware compliance of the targeted devices can not be read
+	ErrFirmwareComplianceGuardCheck = "unable to check the firmware compliance of the targeted devices"
 )
 
 // FailureStatusIDs - list of failure status IDs from OME for a job
@@ -15,10 +15,20 @@ package clients
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
 	"terraform-provider-ome/models"
 )
 
+var (
+	// precheckAttributeRegex matches messages about an attribute, such as "BIOS.Setup.1-1#BootMode: value is not valid"
+	precheckAttributeRegex = regexp.MustCompile(`^(?:[Aa]ttribute\s*[:=]?\s*)?([\w.:-]+#[\w.#:\[\]-]+)\s*[:=,-]\s*(.+)$`)
+	precheckRebootRegex    = regexp.MustCompile(`(?i)\b(reboot|restart|power ?cycle)\b.*\brequired\b|\brequires?\b.*\b(reboot|restart|power ?cycle)\b`)
+	precheckNoRebootRegex  = regexp.MustCompile(`(?i)\bno\b.*\b(reboot|restart|power ?cycle)\b|\bnot required\b`)
+	precheckVlanRegex      = regexp.MustCompile(`(?i)\bvlans?\b.*\b(conflict\w*|not (available|found|present|supported)|unavailable|mismatch\w*|invalid|fail\w*)\b|\b(conflict\w*|mismatch\w*|invalid|fail\w*)\b.*\bvlans?\b`)
+)
+
 // CreateDeployment creates a deployment for a specific template
 func (c *Client) CreateDeployment(deploymentRequest models.OMETemplateDeployRequest) (int64, error) {
 	data, errMarshal := c.JSONMarshal(deploymentRequest)
@@ -92,3 +102,132 @@ func (c *Client) DeleteDeployment(deleteDeploymentReq models.ProfileDeleteReques
 	}
 	return nil
 }
+
+// ParseDeploymentPrecheck parses the execution details of a pre-check only deployment job into the result of every device.
+// A device passes when its execution detail completed successfully, every line of the detail is kept as a message and
+// is further classified as a message about an attribute, a required reboot or a VLAN conflict.
+func ParseDeploymentPrecheck(details []LastExecutionDetail) []models.OMEDeploymentPrecheckResult {
+	results := make([]models.OMEDeploymentPrecheckResult, 0, len(details))
+	for _, detail := range details {
+		result := models.OMEDeploymentPrecheckResult{
+			DeviceID:          detail.IDBaseEntity,
+			Key:               detail.Key,
+			Status:            detail.JobStatus.Name,
+			Passed:            detail.JobStatus.ID == SuccessStatusID,
+			Messages:          []string{},
+			AttributeMessages: []models.OMEPrecheckAttributeMessage{},
+			VlanConflicts:     []string{},
+		}
+		for _, line := range strings.Split(detail.Value, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" {
+				continue
+			}
+			result.Messages = append(result.Messages, line)
+			if match := precheckAttributeRegex.FindStringSubmatch(line); match != nil {
+				result.AttributeMessages = append(result.AttributeMessages, models.OMEPrecheckAttributeMessage{
+					Attribute: match[1],
+					Message:   strings.TrimSpace(match[2]),
+				})
+			}
+			if precheckRebootRegex.MatchString(line) && !precheckNoRebootRegex.MatchString(line) {
+				result.RebootRequired = true
+			}
+			if precheckVlanRegex.MatchString(line) {
+				result.VlanConflicts = append(result.VlanConflicts, line)
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// GetChassisSlots returns the slots of a chassis
+func (c *Client) GetChassisSlots(chassisID int64) ([]models.OMEChassisSlot, error) {
+	response, err := c.Get(fmt.Sprintf(DeviceInventorySingleAPI, chassisID, ChassisSlotsInventoryType), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyData, err := c.GetBodyData(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	slots := models.OMEChassisSlots{}
+	err = c.JSONUnMarshal(bodyData, &slots)
+	if err != nil {
+		return nil, err
+	}
+	return slots.InventoryInfo, nil
+}
+
+// GetChassisComputeSlot returns the compute slot of the chassis with the given service tag
+func (c *Client) GetChassisComputeSlot(chassisServiceTag string, slotNumber int64) (models.OMEChassisSlot, error) {
+	chassis, err := c.GetDevice(chassisServiceTag, 0)
+	if err != nil {
+		return models.OMEChassisSlot{}, err
+	}
+	if chassis.Type != ChassisDeviceType {
+		return models.OMEChassisSlot{}, fmt.Errorf("device %s is not a chassis", chassisServiceTag)
+	}
+	slots, err := c.GetChassisSlots(chassis.ID)
+	if err != nil {
+		return models.OMEChassisSlot{}, err
+	}
+	return FindComputeSlot(slots, chassisServiceTag, slotNumber)
+}
+
+// FindComputeSlot returns the slot with the given number, which must hold a compute sled
+func FindComputeSlot(slots []models.OMEChassisSlot, chassisServiceTag string, slotNumber int64) (models.OMEChassisSlot, error) {
+	for _, slot := range slots {
+		if slot.SlotNumber != strconv.FormatInt(slotNumber, 10) {
+			continue
+		}
+		if slot.SlotType != ComputeSlotType {
+			return models.OMEChassisSlot{}, fmt.Errorf("slot %d of chassis %s is not a compute slot", slotNumber, chassisServiceTag)
+		}
+		return slot, nil
+	}
+	return models.OMEChassisSlot{}, fmt.Errorf("slot %d does not exist in chassis %s", slotNumber, chassisServiceTag)
+}
+
+// CreateProfile creates an unassigned profile from a template and returns its id
+func (c *Client) CreateProfile(templateID int64, namePrefix string) (int64, error) {
+	data, err := c.JSONMarshal(models.OMEProfileCreateRequest{
+		TemplateID:               templateID,
+		NamePrefix:               namePrefix,
+		NumberOfProfilesToCreate: 1,
+	})
+	if err != nil {
+		return -1, err
+	}
+	response, err := c.Post(ProfileAPI, nil, data)
+	if err != nil {
+		return -1, err
+	}
+	respData, err := c.GetBodyData(response.Body)
+	if err != nil {
+		return -1, err
+	}
+	profileIDs := []int64{}
+	err = c.JSONUnMarshal(respData, &profileIDs)
+	if err != nil {
+		return -1, err
+	}
+	if len(profileIDs) == 0 {
+		return -1, fmt.Errorf("no profile was created from the template %d", templateID)
+	}
+	return profileIDs[0], nil
+}
+
+// AssignProfile assigns a profile to a device or a chassis slot
+func (c *Client) AssignProfile(profileID, targetID int64) error {
+	data, err := c.JSONMarshal(models.OMEProfileAssignRequest{
+		ID:       profileID,
+		TargetID: targetID,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.Post(AssignProfileAPI, nil, data)
+	return err
+}
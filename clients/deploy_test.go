@@ -150,3 +150,81 @@ func TestClient_DeleteDeployment(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDeploymentPrecheck(t *testing.T) {
+	details := []LastExecutionDetail{
+		{
+			Key:          "MXL1234",
+			IDBaseEntity: 10001,
+			JobStatus:    JobStatus{ID: SuccessStatusID, Name: "Completed"},
+			Value:        "Pre-check of the template completed.\nNo reboot is required.\r\n",
+		},
+		{
+			Key:          "MXL2345",
+			IDBaseEntity: 10002,
+			JobStatus:    JobStatus{ID: 2070, Name: "Failed"},
+			Value: "BIOS.Setup.1-1#BootMode: The value Legacy is not supported.\n" +
+				"Attribute NIC.Integrated.1-1-1#VLanId = Invalid value\n" +
+				"A system reboot is required to apply the BIOS changes.\n" +
+				"VLAN 20 is not available on the fabric of the device.",
+		},
+	}
+	results := ParseDeploymentPrecheck(details)
+	assert.Len(t, results, 2)
+
+	assert.True(t, results[0].Passed)
+	assert.Equal(t, int64(10001), results[0].DeviceID)
+	assert.Equal(t, "Completed", results[0].Status)
+	assert.False(t, results[0].RebootRequired)
+	assert.Len(t, results[0].Messages, 2)
+	assert.Empty(t, results[0].AttributeMessages)
+	assert.Empty(t, results[0].VlanConflicts)
+
+	assert.False(t, results[1].Passed)
+	assert.True(t, results[1].RebootRequired)
+	assert.Len(t, results[1].Messages, 4)
+	assert.Equal(t, []models.OMEPrecheckAttributeMessage{
+		{Attribute: "BIOS.Setup.1-1#BootMode", Message: "The value Legacy is not supported."},
+		{Attribute: "NIC.Integrated.1-1-1#VLanId", Message: "Invalid value"},
+	}, results[1].AttributeMessages)
+	assert.Equal(t, []string{"VLAN 20 is not available on the fabric of the device."}, results[1].VlanConflicts)
+}
+
+func TestClient_GetChassisComputeSlot(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	slot, err := c.GetChassisComputeSlot("MXCHAS1", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(20002), slot.ID)
+	assert.Equal(t, int64(0), slot.DeviceID)
+
+	_, err = c.GetChassisComputeSlot("MXCHAS1", 9)
+	assert.ErrorContains(t, err, "is not a compute slot")
+
+	_, err = c.GetChassisComputeSlot("MXCHAS1", 12)
+	assert.ErrorContains(t, err, "does not exist in chassis MXCHAS1")
+
+	_, err = c.GetChassisComputeSlot("MXSLED1", 1)
+	assert.ErrorContains(t, err, "device MXSLED1 is not a chassis")
+}
+
+func TestClient_CreateAndAssignProfile(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	profileID, err := c.CreateProfile(1, "slot-profile")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10079), profileID)
+	assert.Nil(t, c.AssignProfile(profileID, 20002))
+
+	_, err = c.CreateProfile(2, "slot-profile")
+	assert.NotNil(t, err)
+	assert.NotNil(t, c.AssignProfile(10080, 20002))
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+	"terraform-provider-ome/models"
+)
+
+// PowerStates - OME power state values by power operation
+var PowerStates = map[string]string{
+	"on":                "2",
+	"power_cycle":       "5",
+	"off":               "8",
+	"reset":             "10",
+	"graceful_shutdown": "12",
+}
+
+// ResetIDRAC - creates a job to reset the iDRAC of devices
+func (c *Client) ResetIDRAC(deviceIDs []int64, opts JobOpts) (JobResp, error) {
+	response, err := c.createDeviceJob(deviceIDs, models.ResetIDRACJobType, models.JobParams{
+		"operationName": "RESET_IDRAC",
+	}, opts)
+	if err != nil {
+		return JobResp{}, fmt.Errorf("error creating iDRAC reset job: %w", err)
+	}
+	return response, nil
+}
+
+// PowerControlDevices - creates a job to apply a power operation on devices
+func (c *Client) PowerControlDevices(deviceIDs []int64, operation string, opts JobOpts) (JobResp, error) {
+	powerState, ok := PowerStates[operation]
+	if !ok {
+		return JobResp{}, fmt.Errorf("invalid power operation %s", operation)
+	}
+	response, err := c.createDeviceJob(deviceIDs, models.PowerControlJobType, models.JobParams{
+		"operationName": "POWER_CONTROL",
+		"powerState":    powerState,
+	}, opts)
+	if err != nil {
+		return JobResp{}, fmt.Errorf("error creating power control job: %w", err)
+	}
+	return response, nil
+}
+
+// RunJobs - runs the given jobs immediately
+func (c *Client) RunJobs(jobIDs []int64) error {
+	payload := map[string]interface{}{
+		"JobIds":  jobIDs,
+		"AllJobs": false,
+	}
+	body, err := c.JSONMarshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = c.Post(RunJobsAPI, nil, body)
+	return err
+}
+
+// RefreshCatalogs - refreshes the given catalogs from their repository
+func (c *Client) RefreshCatalogs(catalogIDs []int64) error {
+	payload := map[string]interface{}{
+		"CatalogIds":  catalogIDs,
+		"AllCatalogs": false,
+	}
+	body, err := c.JSONMarshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = c.Post(RefreshCatalogsAPI, nil, body)
+	return err
+}
+
+func (c *Client) createDeviceJob(deviceIDs []int64, jobType models.JobType, params models.JobParams, opts JobOpts) (JobResp, error) {
+	targets := make([]models.JobTargetType, 0)
+	for _, id := range deviceIDs {
+		targets = append(targets, models.JobTargetType{
+			ID:         id,
+			TargetType: models.DeviceTargetType,
+		})
+	}
+	payload := models.JobPayload{
+		Enabled:        true,
+		JobName:        opts.Name,
+		JobDescription: opts.Description,
+		Schedule:       opts.getSchedule(),
+		JobType:        jobType,
+		Params:         params,
+		Targets:        targets,
+	}
+	return c.CreateJob(payload)
+}
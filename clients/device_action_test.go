@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ResetIDRAC(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	c, _ := NewClient(initOptions(ts))
+
+	v, err := c.ResetIDRAC([]int64{1, 2}, JobOpts{
+		Name:        "valid",
+		Description: "valid job",
+		RunNow:      true,
+	})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, v.JobName)
+
+	_, err = c.ResetIDRAC([]int64{1000, 2000}, JobOpts{
+		Name:        "invalid",
+		Description: "invalid job",
+		RunNow:      true,
+	})
+	assert.NotNil(t, err)
+}
+
+func TestClient_PowerControlDevices(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	c, _ := NewClient(initOptions(ts))
+
+	v, err := c.PowerControlDevices([]int64{1, 2}, "graceful_shutdown", JobOpts{
+		Name:        "valid",
+		Description: "valid job",
+		RunNow:      true,
+	})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, v.JobName)
+
+	_, err = c.PowerControlDevices([]int64{1, 2}, "hibernate", JobOpts{
+		Name: "valid",
+	})
+	assert.ErrorContains(t, err, "invalid power operation")
+
+	_, err = c.PowerControlDevices([]int64{1000, 2000}, "on", JobOpts{
+		Name:        "invalid",
+		Description: "invalid job",
+		RunNow:      true,
+	})
+	assert.NotNil(t, err)
+}
+
+func TestClient_RunJobs(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	c, _ := NewClient(initOptions(ts))
+
+	assert.Nil(t, c.RunJobs([]int64{10}))
+	assert.NotNil(t, c.RunJobs([]int64{-1}))
+}
+
+func TestClient_RefreshCatalogs(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	c, _ := NewClient(initOptions(ts))
+
+	assert.Nil(t, c.RefreshCatalogs([]int64{10}))
+	assert.NotNil(t, c.RefreshCatalogs([]int64{-1}))
+}
@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-ome/models"
+)
+
+// nicPartitionRegex splits the identifier of a NIC partition, such as NIC.Mezzanine.1A-1-1, into its NIC and partition
+var nicPartitionRegex = regexp.MustCompile(`^(.+-\d+)-(\d+)$`)
+
+// GetIdentityPoolUsage returns the identities in use of every identity set of the identity pool
+func (c *Client) GetIdentityPoolUsage(poolID int64) ([]models.OMEIdentityUsage, error) {
+	identitySets := []models.OMEIdentitySet{}
+	err := c.GetPaginatedData(fmt.Sprintf(IdentityPoolUsageSetsAPI, poolID), &identitySets)
+	if err != nil {
+		return nil, err
+	}
+	usage := []models.OMEIdentityUsage{}
+	for _, identitySet := range identitySets {
+		details := []models.OMEIdentityUsage{}
+		err := c.GetPaginatedData(fmt.Sprintf(IdentityPoolUsageDetailsAPI, poolID, identitySet.IdentitySetID), &details)
+		if err != nil {
+			return nil, err
+		}
+		for i := range details {
+			if details[i].IdentityType == "" {
+				details[i].IdentityType = identitySet.Name
+			}
+		}
+		usage = append(usage, details...)
+	}
+	return usage, nil
+}
+
+// DeviceIdentitiesFromUsage groups the identities in use by device and NIC partition.
+// The device of an identity is taken from its device info, or else from the server profile it was assigned through.
+// Identities which cannot be related to a device, such as the ones reserved for a chassis slot, are left out.
+func DeviceIdentitiesFromUsage(usage []models.OMEIdentityUsage, profiles []models.OMEServerProfile) []models.OMEDeviceIdentity {
+	profileTargets := map[string]int64{}
+	for _, profile := range profiles {
+		profileTargets[profile.ProfileName] = profile.TargetID
+	}
+
+	identities := map[string]*models.OMEDeviceIdentity{}
+	for _, identityUsage := range usage {
+		deviceID := identityUsage.DeviceInfo.ID
+		if deviceID == 0 {
+			deviceID = profileTargets[identityUsage.ProfileName]
+		}
+		if deviceID == 0 || identityUsage.NicIdentifier == "" {
+			continue
+		}
+		key := fmt.Sprintf("%d/%s", deviceID, identityUsage.NicIdentifier)
+		identity, ok := identities[key]
+		if !ok {
+			identity = &models.OMEDeviceIdentity{
+				DeviceID:    deviceID,
+				ServiceTag:  identityUsage.DeviceInfo.ServiceTag,
+				ProfileName: identityUsage.ProfileName,
+				NicID:       identityUsage.NicIdentifier,
+			}
+			if match := nicPartitionRegex.FindStringSubmatch(identityUsage.NicIdentifier); match != nil {
+				identity.NicID = match[1]
+				identity.Partition, _ = strconv.ParseInt(match[2], 10, 64)
+			}
+			identities[key] = identity
+		}
+		if identity.ServiceTag == "" {
+			identity.ServiceTag = identityUsage.DeviceInfo.ServiceTag
+		}
+		switch strings.ToLower(identityUsage.IdentityType) {
+		case "ethernet":
+			identity.EthernetMac = identityUsage.MacAddress
+		case "iscsi":
+			identity.IscsiMac = identityUsage.MacAddress
+			identity.IscsiIQN = identityUsage.IQN
+		case "fcoe":
+			identity.FcoeMac = identityUsage.MacAddress
+			identity.FcoeWWPN = identityUsage.WWPN
+			identity.FcoeWWNN = identityUsage.WWNN
+		case "fc":
+			identity.FcWWPN = identityUsage.WWPN
+			identity.FcWWNN = identityUsage.WWNN
+		}
+	}
+
+	deviceIdentities := make([]models.OMEDeviceIdentity, 0, len(identities))
+	for _, identity := range identities {
+		deviceIdentities = append(deviceIdentities, *identity)
+	}
+	sort.Slice(deviceIdentities, func(i, j int) bool {
+		a, b := deviceIdentities[i], deviceIdentities[j]
+		if a.DeviceID != b.DeviceID {
+			return a.DeviceID < b.DeviceID
+		}
+		if a.NicID != b.NicID {
+			return a.NicID < b.NicID
+		}
+		return a.Partition < b.Partition
+	})
+	return deviceIdentities
+}
@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"terraform-provider-ome/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetIdentityPoolUsage(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+	c, _ := NewClient(opts)
+
+	usage, err := c.GetIdentityPoolUsage(123)
+	assert.Nil(t, err)
+	assert.Len(t, usage, 2)
+	assert.Equal(t, "Ethernet", usage[0].IdentityType)
+	assert.Equal(t, int64(10001), usage[0].DeviceInfo.ID)
+	assert.Equal(t, "iSCSI", usage[1].IdentityType)
+	assert.Equal(t, "iqn.1988-11.com.dell:01:0000000001", usage[1].IQN)
+
+	_, err = c.GetIdentityPoolUsage(124)
+	assert.NotNil(t, err)
+}
+
+func TestDeviceIdentitiesFromUsage(t *testing.T) {
+	profileName := "Profile from template 'Test Template' 00001"
+	usage := []models.OMEIdentityUsage{
+		{IdentityType: "iSCSI", ProfileName: profileName, NicIdentifier: "NIC.Mezzanine.1A-1-1", MacAddress: "04:0B:00:00:00:01", IQN: "iqn.1988-11.com.dell:01"},
+		{IdentityType: "Ethernet", ProfileName: profileName, NicIdentifier: "NIC.Mezzanine.1A-1-1", MacAddress: "04:0A:00:00:00:01",
+			DeviceInfo: models.OMEIdentityDeviceInfo{ID: 10001, ServiceTag: "SVCTAG1"}},
+		{IdentityType: "Ethernet", ProfileName: profileName, NicIdentifier: "NIC.Integrated.1-2-1", MacAddress: "04:0A:00:00:00:02"},
+		{IdentityType: "FC", ProfileName: "Profile of another template", NicIdentifier: "FC.Mezzanine.2A-1", WWPN: "20:00:00:00:00:00:00:01", WWNN: "20:01:00:00:00:00:00:01"},
+		{IdentityType: "Ethernet", ProfileName: "Reserved for a slot", NicIdentifier: "NIC.Mezzanine.1A-2-1", MacAddress: "04:0A:00:00:00:03"},
+	}
+	profiles := []models.OMEServerProfile{
+		{ProfileName: profileName, TargetID: 10001},
+		{ProfileName: "Profile of another template", TargetID: 10002},
+	}
+
+	identities := DeviceIdentitiesFromUsage(usage, profiles)
+	assert.Len(t, identities, 3)
+
+	assert.Equal(t, int64(10001), identities[0].DeviceID)
+	assert.Equal(t, "NIC.Integrated.1-2", identities[0].NicID)
+	assert.Equal(t, int64(1), identities[0].Partition)
+	assert.Equal(t, "04:0A:00:00:00:02", identities[0].EthernetMac)
+
+	assert.Equal(t, "NIC.Mezzanine.1A-1", identities[1].NicID)
+	assert.Equal(t, "SVCTAG1", identities[1].ServiceTag)
+	assert.Equal(t, "04:0A:00:00:00:01", identities[1].EthernetMac)
+	assert.Equal(t, "04:0B:00:00:00:01", identities[1].IscsiMac)
+	assert.Equal(t, "iqn.1988-11.com.dell:01", identities[1].IscsiIQN)
+
+	assert.Equal(t, int64(10002), identities[2].DeviceID)
+	assert.Equal(t, "FC.Mezzanine.2A-1", identities[2].NicID)
+	assert.Equal(t, int64(0), identities[2].Partition)
+	assert.Equal(t, "20:00:00:00:00:00:00:01", identities[2].FcWWPN)
+}
@@ -91,3 +91,12 @@ func (c *Client) GetDiscoveryJobByGroupID(groupID int64) (models.DiscoveryJob, e
 	err = c.JSONUnMarshal(respData, &omeDiscoveryJob)
 	return omeDiscoveryJob, err
 }
+
+// GetAllDiscoveryJobs - get all the discovery jobs.
+func (c *Client) GetAllDiscoveryJobs() ([]models.DiscoveryJob, error) {
+	omeDiscoveryJobs := []models.DiscoveryJob{}
+	err := c.GetValueWithPagination(RequestOptions{
+		URL: DiscoveryJobAPI,
+	}, &omeDiscoveryJobs)
+	return omeDiscoveryJobs, err
+}
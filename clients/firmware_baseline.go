@@ -77,6 +77,15 @@ func (c *Client) GetFirmwareBaselineWithName(name string) (models.FirmwareBaseli
 	return models.FirmwareBaselinesModel{}, nil
 }
 
+// GetAllFirmwareBaselines - Gets all the firmware baselines
+func (c *Client) GetAllFirmwareBaselines() ([]models.FirmwareBaselinesModel, error) {
+	omeBaselines := []models.FirmwareBaselinesModel{}
+	err := c.GetValueWithPagination(RequestOptions{
+		URL: FirmwareBaselineAPI,
+	}, &omeBaselines)
+	return omeBaselines, err
+}
+
 // DeleteFirmwareBaseline - Deletes the specified baseline
 func (c *Client) DeleteFirmwareBaseline(ids []int64) error {
 	if len(ids) == 0 {
@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-ome/models"
+	"terraform-provider-ome/utils"
+)
+
+// FirmwareComplianceViolations - returns the devices of the baselines whose firmware compliance is at least as severe as the severity.
+// Only the components reaching the severity are kept, the baselines can be limited by name.
+func FirmwareComplianceViolations(baselines []models.FirmwareBaselinesGetModel, severity string, baselineNames []string) []models.FirmwareComplianceViolation {
+	threshold := FirmwareComplianceSeverity(severity)
+	violations := []models.FirmwareComplianceViolation{}
+	for _, baseline := range baselines {
+		if len(baselineNames) > 0 && !utils.ContainsFold(baselineNames, baseline.Name) {
+			continue
+		}
+		for _, device := range baseline.DeviceComplianceReport {
+			components := []models.ComponentComplianceReportModel{}
+			for _, component := range device.ComponentComplianceReports {
+				if FirmwareComplianceSeverity(component.ComplianceStatus) >= threshold {
+					components = append(components, component)
+				}
+			}
+			if len(components) == 0 && FirmwareComplianceSeverity(device.ComplianceStatus) < threshold {
+				continue
+			}
+			sort.SliceStable(components, func(i, j int) bool {
+				return components[i].Name < components[j].Name
+			})
+			violations = append(violations, models.FirmwareComplianceViolation{
+				DeviceID:         device.DeviceID,
+				ServiceTag:       device.ServiceTag,
+				DeviceName:       device.DeviceName,
+				BaselineName:     baseline.Name,
+				ComplianceStatus: device.ComplianceStatus,
+				Components:       components,
+			})
+		}
+	}
+	sort.SliceStable(violations, func(i, j int) bool {
+		if violations[i].ServiceTag != violations[j].ServiceTag {
+			return violations[i].ServiceTag < violations[j].ServiceTag
+		}
+		return violations[i].BaselineName < violations[j].BaselineName
+	})
+	return violations
+}
+
+// FirmwareComplianceViolationsDetail - describes every device tripping the firmware compliance guard followed by its offending components
+func FirmwareComplianceViolationsDetail(violations []models.FirmwareComplianceViolation) string {
+	lines := []string{}
+	for _, violation := range violations {
+		lines = append(lines, fmt.Sprintf("%s (%s) is %s against baseline %s:", violation.ServiceTag, violation.DeviceName, violation.ComplianceStatus, violation.BaselineName))
+		for _, component := range violation.Components {
+			lines = append(lines, fmt.Sprintf("  - %s %s -> %s (%s)", component.Name, component.CurrentVersion, component.Version, component.ComplianceStatus))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
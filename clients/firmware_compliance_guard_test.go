@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"terraform-provider-ome/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_FirmwareComplianceViolations(t *testing.T) {
+	bios := models.ComponentComplianceReportModel{Name: "BIOS", CurrentVersion: "2.9.1", Version: "2.19.1", ComplianceStatus: string(models.CRITICAL)}
+	nic := models.ComponentComplianceReportModel{Name: "NIC", CurrentVersion: "22.5.7", Version: "21.80.11", ComplianceStatus: string(models.DOWNGRADE)}
+	idrac := models.ComponentComplianceReportModel{Name: "iDRAC", CurrentVersion: "6.10.00.00", Version: "7.00.60.00", ComplianceStatus: string(models.WARNING)}
+	baselines := []models.FirmwareBaselinesGetModel{
+		{
+			Name: "baseline-a",
+			DeviceComplianceReport: []models.DeviceComplianceModel{
+				{DeviceID: 1, ServiceTag: "TAG1", DeviceName: "host-1", ComplianceStatus: "CRITICAL", ComponentComplianceReports: []models.ComponentComplianceReportModel{idrac, nic, bios}},
+				{DeviceID: 2, ServiceTag: "TAG2", DeviceName: "host-2", ComplianceStatus: "DOWNGRADE", ComponentComplianceReports: []models.ComponentComplianceReportModel{nic}},
+			},
+		},
+		{
+			Name: "baseline-b",
+			DeviceComplianceReport: []models.DeviceComplianceModel{
+				{DeviceID: 2, ServiceTag: "TAG2", DeviceName: "host-2", ComplianceStatus: "WARNING", ComponentComplianceReports: []models.ComponentComplianceReportModel{idrac}},
+				{DeviceID: 3, ServiceTag: "TAG3", DeviceName: "host-3", ComplianceStatus: "OK"},
+			},
+		},
+	}
+
+	violations := FirmwareComplianceViolations(baselines, string(models.CRITICAL), nil)
+	assert.Len(t, violations, 1)
+	assert.Equal(t, "TAG1", violations[0].ServiceTag)
+	assert.Equal(t, []models.ComponentComplianceReportModel{bios}, violations[0].Components)
+	assert.Equal(t, "TAG1 (host-1) is CRITICAL against baseline baseline-a:\n  - BIOS 2.9.1 -> 2.19.1 (CRITICAL)", FirmwareComplianceViolationsDetail(violations))
+
+	// warnings include the critical components, sorted by name
+	violations = FirmwareComplianceViolations(baselines, string(models.WARNING), nil)
+	assert.Len(t, violations, 2)
+	assert.Equal(t, []models.ComponentComplianceReportModel{bios, idrac}, violations[0].Components)
+	assert.Equal(t, "TAG2", violations[1].ServiceTag)
+	assert.Equal(t, "baseline-b", violations[1].BaselineName)
+
+	violations = FirmwareComplianceViolations(baselines, string(models.DOWNGRADE), []string{"BASELINE-A"})
+	assert.Len(t, violations, 2)
+	assert.Equal(t, "baseline-a", violations[1].BaselineName)
+	assert.Equal(t, []models.ComponentComplianceReportModel{nic}, violations[1].Components)
+
+	assert.Empty(t, FirmwareComplianceViolations(baselines, string(models.CRITICAL), []string{"baseline-b"}))
+	assert.Empty(t, FirmwareComplianceViolations(nil, string(models.DOWNGRADE), nil))
+}
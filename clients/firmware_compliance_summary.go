@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"terraform-provider-ome/models"
+	"terraform-provider-ome/utils"
+)
+
+// SummarizeFirmwareCompliance - aggregates the firmware compliance reports of the baselines, keyed by baseline name, per device.
+// A device targeted by several baselines counts once, with the most severe of its statuses.
+// deviceIDs limits the summary to those devices when not nil and deviceModels to those models, case insensitive, when not empty.
+func SummarizeFirmwareCompliance(reports map[string][]models.DeviceComplianceReport, deviceIDs map[int64]bool, deviceModels []string, worstDevicesLimit, outdatedComponentsLimit int) models.FirmwareComplianceSummary {
+	devices := map[int64]*models.FirmwareComplianceDevice{}
+	nonCompliant := map[int64]map[string]string{}
+	components := map[string]*models.FirmwareOutdatedComponent{}
+	componentDevices := map[string]map[int64]bool{}
+
+	baselineNames := make([]string, 0, len(reports))
+	for name := range reports {
+		baselineNames = append(baselineNames, name)
+	}
+	sort.Strings(baselineNames)
+
+	for _, baselineName := range baselineNames {
+		for _, report := range reports[baselineName] {
+			deviceID := int64(report.DeviceID)
+			if (deviceIDs != nil && !deviceIDs[deviceID]) || (len(deviceModels) > 0 && !utils.ContainsFold(deviceModels, report.DeviceModel)) {
+				continue
+			}
+			device, ok := devices[deviceID]
+			if !ok {
+				device = &models.FirmwareComplianceDevice{
+					DeviceID:         deviceID,
+					DeviceName:       report.DeviceName,
+					ServiceTag:       report.ServiceTag,
+					DeviceModel:      report.DeviceModel,
+					ComplianceStatus: string(models.OK),
+				}
+				devices[deviceID] = device
+				nonCompliant[deviceID] = map[string]string{}
+			}
+			device.BaselineNames = append(device.BaselineNames, baselineName)
+			device.RebootRequired = device.RebootRequired || report.RebootRequired
+
+			statuses := []string{device.ComplianceStatus}
+			if report.ComplianceStatus != nil {
+				statuses = append(statuses, string(*report.ComplianceStatus))
+			}
+			for _, c := range report.ComponentComplianceReports {
+				statuses = append(statuses, c.ComplianceStatus)
+				if FirmwareComplianceSeverity(c.ComplianceStatus) == FirmwareComplianceSeverity(string(models.OK)) {
+					continue
+				}
+				// the same component can be reported by several baselines of the device
+				key := c.SourceName
+				if key == "" {
+					key = c.Name
+				}
+				nonCompliant[deviceID][key] = worstFirmwareStatus([]string{nonCompliant[deviceID][key], c.ComplianceStatus})
+				device.RebootRequired = device.RebootRequired || c.RebootRequired
+
+				if !strings.EqualFold(c.UpdateAction, FirmwareUpdateActionUpgrade) {
+					continue
+				}
+				component, ok := components[c.Name]
+				if !ok {
+					component = &models.FirmwareOutdatedComponent{
+						Name:             c.Name,
+						ComponentType:    c.ComponentType,
+						ComplianceStatus: string(models.OK),
+					}
+					components[c.Name] = component
+					componentDevices[c.Name] = map[int64]bool{}
+				}
+				component.ComplianceStatus = worstFirmwareStatus([]string{component.ComplianceStatus, c.ComplianceStatus})
+				if CompareFirmwareVersions(c.Version, component.Version) > 0 {
+					component.Version = c.Version
+				}
+				componentDevices[c.Name][deviceID] = true
+			}
+			device.ComplianceStatus = worstFirmwareStatus(statuses)
+		}
+	}
+
+	summary := models.FirmwareComplianceSummary{
+		TotalDevices: int64(len(devices)),
+		StatusCounts: map[string]int64{
+			string(models.OK):        0,
+			string(models.WARNING):   0,
+			string(models.CRITICAL):  0,
+			string(models.DOWNGRADE): 0,
+			string(models.UNKNOWN):   0,
+		},
+		WorstDevices:       []models.FirmwareComplianceDevice{},
+		OutdatedComponents: []models.FirmwareOutdatedComponent{},
+	}
+	for deviceID, device := range devices {
+		for _, status := range nonCompliant[deviceID] {
+			device.NonCompliantComponents++
+			if strings.EqualFold(status, string(models.CRITICAL)) {
+				device.CriticalComponents++
+			}
+		}
+		status := device.ComplianceStatus
+		if _, ok := summary.StatusCounts[status]; !ok {
+			status = string(models.UNKNOWN)
+		}
+		summary.StatusCounts[status]++
+		if device.RebootRequired {
+			summary.RebootRequiredDevices++
+		}
+		if FirmwareComplianceSeverity(device.ComplianceStatus) > FirmwareComplianceSeverity(string(models.OK)) {
+			summary.WorstDevices = append(summary.WorstDevices, *device)
+		}
+	}
+	if summary.TotalDevices > 0 {
+		percentage := float64(summary.RebootRequiredDevices) * 100 / float64(summary.TotalDevices)
+		summary.RebootRequiredPercentage = math.Round(percentage*100) / 100
+	}
+
+	sort.Slice(summary.WorstDevices, func(i, j int) bool {
+		a, b := summary.WorstDevices[i], summary.WorstDevices[j]
+		if sa, sb := FirmwareComplianceSeverity(a.ComplianceStatus), FirmwareComplianceSeverity(b.ComplianceStatus); sa != sb {
+			return sa > sb
+		}
+		if a.NonCompliantComponents != b.NonCompliantComponents {
+			return a.NonCompliantComponents > b.NonCompliantComponents
+		}
+		return a.ServiceTag < b.ServiceTag
+	})
+	if worstDevicesLimit > 0 && len(summary.WorstDevices) > worstDevicesLimit {
+		summary.WorstDevices = summary.WorstDevices[:worstDevicesLimit]
+	}
+
+	for name, component := range components {
+		component.DeviceCount = int64(len(componentDevices[name]))
+		summary.OutdatedComponents = append(summary.OutdatedComponents, *component)
+	}
+	sort.Slice(summary.OutdatedComponents, func(i, j int) bool {
+		a, b := summary.OutdatedComponents[i], summary.OutdatedComponents[j]
+		if a.DeviceCount != b.DeviceCount {
+			return a.DeviceCount > b.DeviceCount
+		}
+		return a.Name < b.Name
+	})
+	if outdatedComponentsLimit > 0 && len(summary.OutdatedComponents) > outdatedComponentsLimit {
+		summary.OutdatedComponents = summary.OutdatedComponents[:outdatedComponentsLimit]
+	}
+	return summary
+}
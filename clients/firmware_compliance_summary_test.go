@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"terraform-provider-ome/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func firmwareSummaryReport(deviceID int32, tag, model string, status models.ComplianceStatusType, components ...models.ComponentComplianceReport) models.DeviceComplianceReport {
+	return models.DeviceComplianceReport{
+		DeviceID:                   deviceID,
+		DeviceName:                 "host-" + tag,
+		ServiceTag:                 tag,
+		DeviceModel:                model,
+		ComplianceStatus:           &status,
+		ComponentComplianceReports: components,
+	}
+}
+
+func TestClient_SummarizeFirmwareCompliance(t *testing.T) {
+	bios := func(version, status string, reboot bool) models.ComponentComplianceReport {
+		return models.ComponentComplianceReport{Name: "BIOS", SourceName: "DCIM:INSTALLED#741__BIOS.Setup.1-1", ComponentType: "BIOS",
+			Version: version, CurrentVersion: "2.9.1", UpdateAction: FirmwareUpdateActionUpgrade, ComplianceStatus: status, RebootRequired: reboot}
+	}
+	nic := models.ComponentComplianceReport{Name: "NIC", SourceName: "DCIM:INSTALLED#701__NIC.Mezzanine.1A-1-1", ComponentType: "FRMW",
+		Version: "21.80.11", CurrentVersion: "22.5.7", UpdateAction: FirmwareUpdateActionDowngrade, ComplianceStatus: string(models.DOWNGRADE)}
+	idrac := models.ComponentComplianceReport{Name: "iDRAC", SourceName: "DCIM:INSTALLED#iDRAC.Embedded.1-1#IDRACinfo", ComponentType: "FRMW",
+		Version: "7.00.60.00", CurrentVersion: "7.00.60.00", UpdateAction: FirmwareUpdateActionEqual, ComplianceStatus: string(models.OK)}
+
+	reports := map[string][]models.DeviceComplianceReport{
+		"baseline-a": {
+			firmwareSummaryReport(1, "TAG1", "PowerEdge R650", models.CRITICAL, bios("2.19.1", string(models.CRITICAL), true), idrac),
+			firmwareSummaryReport(2, "TAG2", "PowerEdge R750", models.WARNING, bios("2.18.0", string(models.WARNING), false)),
+			firmwareSummaryReport(3, "TAG3", "PowerEdge R650", models.OK, idrac),
+		},
+		"baseline-b": {
+			// device 1 is reported again by the second baseline, its BIOS counts once
+			firmwareSummaryReport(1, "TAG1", "PowerEdge R650", models.DOWNGRADE, bios("2.19.1", string(models.WARNING), false), nic),
+			firmwareSummaryReport(4, "TAG4", "PowerEdge R650", models.DOWNGRADE, nic),
+		},
+	}
+
+	summary := SummarizeFirmwareCompliance(reports, nil, nil, 10, 10)
+	assert.Equal(t, int64(4), summary.TotalDevices)
+	assert.Equal(t, map[string]int64{"OK": 1, "WARNING": 1, "CRITICAL": 1, "DOWNGRADE": 1, "UNKNOWN": 0}, summary.StatusCounts)
+	assert.Equal(t, int64(1), summary.RebootRequiredDevices)
+	assert.Equal(t, 25.0, summary.RebootRequiredPercentage)
+
+	assert.Len(t, summary.WorstDevices, 3)
+	assert.Equal(t, "TAG1", summary.WorstDevices[0].ServiceTag)
+	assert.Equal(t, string(models.CRITICAL), summary.WorstDevices[0].ComplianceStatus)
+	assert.Equal(t, []string{"baseline-a", "baseline-b"}, summary.WorstDevices[0].BaselineNames)
+	assert.Equal(t, int64(2), summary.WorstDevices[0].NonCompliantComponents)
+	assert.Equal(t, int64(1), summary.WorstDevices[0].CriticalComponents)
+	assert.True(t, summary.WorstDevices[0].RebootRequired)
+	assert.Equal(t, "TAG2", summary.WorstDevices[1].ServiceTag)
+	assert.Equal(t, "TAG4", summary.WorstDevices[2].ServiceTag)
+
+	assert.Equal(t, []models.FirmwareOutdatedComponent{
+		{Name: "BIOS", ComponentType: "BIOS", ComplianceStatus: string(models.CRITICAL), Version: "2.19.1", DeviceCount: 2},
+	}, summary.OutdatedComponents)
+
+	// filtered by device and model, and limited
+	summary = SummarizeFirmwareCompliance(reports, map[int64]bool{1: true, 2: true, 3: true}, []string{"poweredge r650"}, 1, 10)
+	assert.Equal(t, int64(2), summary.TotalDevices)
+	assert.Equal(t, int64(1), summary.StatusCounts["CRITICAL"])
+	assert.Equal(t, int64(1), summary.StatusCounts["OK"])
+	assert.Equal(t, 50.0, summary.RebootRequiredPercentage)
+	assert.Len(t, summary.WorstDevices, 1)
+	assert.Equal(t, int64(1), summary.OutdatedComponents[0].DeviceCount)
+
+	// no devices left
+	summary = SummarizeFirmwareCompliance(reports, map[int64]bool{}, nil, 10, 10)
+	assert.Equal(t, int64(0), summary.TotalDevices)
+	assert.Equal(t, 0.0, summary.RebootRequiredPercentage)
+	assert.Empty(t, summary.WorstDevices)
+	assert.Empty(t, summary.OutdatedComponents)
+}
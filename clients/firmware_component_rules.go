@@ -0,0 +1,216 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"terraform-provider-ome/models"
+)
+
+// firmwareStatusSeverity orders the firmware compliance statuses from compliant to critically out of compliance
+var firmwareStatusSeverity = map[string]int{
+	string(models.OK):        0,
+	string(models.UNKNOWN):   1,
+	string(models.DOWNGRADE): 2,
+	string(models.WARNING):   3,
+	string(models.CRITICAL):  4,
+}
+
+// FirmwareComplianceSeverity - returns the severity of a firmware compliance status, unknown statuses rank as UNKNOWN.
+func FirmwareComplianceSeverity(status string) int {
+	if severity, ok := firmwareStatusSeverity[strings.ToUpper(status)]; ok {
+		return severity
+	}
+	return firmwareStatusSeverity[string(models.UNKNOWN)]
+}
+
+// ValidateFirmwareComponentRules - checks that every rule targets a component and that only pin rules set a version.
+func ValidateFirmwareComponentRules(rules []models.FirmwareComponentRule) error {
+	for i, rule := range rules {
+		if (rule.SourceName == "") == (rule.ComponentID == "") {
+			return fmt.Errorf(ErrFirmwareComponentRule, i, "exactly one of source_name or component_id must be set")
+		}
+		if _, err := path.Match(rule.SourceName, ""); err != nil {
+			return fmt.Errorf(ErrFirmwareComponentRule, i, "source_name "+rule.SourceName+" is not a valid pattern")
+		}
+		switch {
+		case strings.EqualFold(rule.Action, FirmwareRulePin) && rule.Version == "":
+			return fmt.Errorf(ErrFirmwareComponentRule, i, "version is required to pin a component")
+		case strings.EqualFold(rule.Action, FirmwareRuleExclude) && rule.Version != "":
+			return fmt.Errorf(ErrFirmwareComponentRule, i, "version can not be set to exclude a component")
+		}
+	}
+	return nil
+}
+
+// ApplyFirmwareComponentRules - rewrites the firmware baseline compliance report of the devices with the component rules of the baseline.
+// Excluded components are reported as EXCLUDED and compliant, pinned components are compared against the pinned version
+// and the compliance status of every device a rule matched is recomputed from its components.
+func ApplyFirmwareComponentRules(reports []models.DeviceComplianceReport, rules []models.FirmwareComponentRule) {
+	if len(rules) == 0 {
+		return
+	}
+	for i := range reports {
+		components := make([]firmwareComponent, 0, len(reports[i].ComponentComplianceReports))
+		for j := range reports[i].ComponentComplianceReports {
+			c := &reports[i].ComponentComplianceReports[j]
+			components = append(components, firmwareComponent{c.SourceName, c.TargetIdentifier, c.CurrentVersion, &c.Version, &c.UpdateAction, &c.ComplianceStatus})
+		}
+		if status, matched := applyDeviceComponentRules(rules, components); matched {
+			complianceStatus := models.ComplianceStatusType(status)
+			reports[i].ComplianceStatus = &complianceStatus
+		}
+	}
+}
+
+// ApplyDeviceFirmwareComponentRules - rewrites the compliance reports of the devices of the firmware baselines with the component rules of each baseline, keyed by baseline name.
+func ApplyDeviceFirmwareComponentRules(baselines []models.FirmwareBaselinesGetModel, rules map[string][]models.FirmwareComponentRule) {
+	for i := range baselines {
+		baselineRules := firmwareBaselineRules(rules, baselines[i].Name)
+		if len(baselineRules) == 0 {
+			continue
+		}
+		for j := range baselines[i].DeviceComplianceReport {
+			device := &baselines[i].DeviceComplianceReport[j]
+			components := make([]firmwareComponent, 0, len(device.ComponentComplianceReports))
+			for k := range device.ComponentComplianceReports {
+				c := &device.ComponentComplianceReports[k]
+				components = append(components, firmwareComponent{c.SourceName, c.TargetIdentifier, c.CurrentVersion, &c.Version, &c.UpdateAction, &c.ComplianceStatus})
+			}
+			if status, matched := applyDeviceComponentRules(baselineRules, components); matched {
+				device.ComplianceStatus = status
+			}
+		}
+	}
+}
+
+// firmwareComponent holds the fields of a component compliance report read and rewritten by the component rules,
+// the compliance reports of the update service and of the firmware baselines have distinct component types
+type firmwareComponent struct {
+	sourceName     string
+	componentID    string
+	currentVersion string
+	version        *string
+	updateAction   *string
+	status         *string
+}
+
+// applyDeviceComponentRules applies the rules to the components of a device and returns the compliance status of the device
+// recomputed from its components, and whether a rule matched one of them
+func applyDeviceComponentRules(rules []models.FirmwareComponentRule, components []firmwareComponent) (string, bool) {
+	matched := false
+	statuses := make([]string, 0, len(components))
+	for _, c := range components {
+		if applyFirmwareComponentRules(rules, c) {
+			matched = true
+		}
+		statuses = append(statuses, *c.status)
+	}
+	return worstFirmwareStatus(statuses), matched
+}
+
+// firmwareBaselineRules returns the rules of the baseline, baseline names are case insensitive
+func firmwareBaselineRules(rules map[string][]models.FirmwareComponentRule, name string) []models.FirmwareComponentRule {
+	for baselineName, baselineRules := range rules {
+		if strings.EqualFold(baselineName, name) {
+			return baselineRules
+		}
+	}
+	return nil
+}
+
+// applyFirmwareComponentRules applies the first rule matching the component to its baseline version, update action and compliance status
+// and returns whether a rule matched
+func applyFirmwareComponentRules(rules []models.FirmwareComponentRule, c firmwareComponent) bool {
+	version, updateAction, status := c.version, c.updateAction, c.status
+	for _, rule := range rules {
+		if !firmwareComponentRuleMatches(rule, c.sourceName, c.componentID) {
+			continue
+		}
+		if !strings.EqualFold(rule.Action, FirmwareRulePin) {
+			*updateAction, *status = FirmwareUpdateActionExcluded, string(models.OK)
+			return true
+		}
+		*version = rule.Version
+		switch cmp := CompareFirmwareVersions(c.currentVersion, rule.Version); {
+		case cmp == 0:
+			*updateAction, *status = FirmwareUpdateActionEqual, string(models.OK)
+		case cmp > 0:
+			*updateAction, *status = FirmwareUpdateActionDowngrade, string(models.DOWNGRADE)
+		default:
+			*updateAction = FirmwareUpdateActionUpgrade
+			// a component the catalog found compliant is still out of date against its pinned version
+			if FirmwareComplianceSeverity(*status) < FirmwareComplianceSeverity(string(models.WARNING)) {
+				*status = string(models.WARNING)
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// firmwareComponentRuleMatches returns whether the rule targets the component, by component id or by source name pattern
+func firmwareComponentRuleMatches(rule models.FirmwareComponentRule, sourceName, componentID string) bool {
+	if rule.ComponentID != "" {
+		return rule.ComponentID == componentID
+	}
+	ok, _ := path.Match(strings.ToLower(rule.SourceName), strings.ToLower(sourceName))
+	return ok
+}
+
+// worstFirmwareStatus returns the most severe of the compliance statuses, OK when there are none
+func worstFirmwareStatus(statuses []string) string {
+	worst := string(models.OK)
+	for _, status := range statuses {
+		if FirmwareComplianceSeverity(status) > FirmwareComplianceSeverity(worst) {
+			worst = strings.ToUpper(status)
+		}
+	}
+	return worst
+}
+
+// CompareFirmwareVersions - compares two firmware versions part by part, numerically when both parts are numbers.
+// Returns -1, 0 or 1 when a is older than, equal to or newer than b.
+func CompareFirmwareVersions(a, b string) int {
+	split := func(r rune) bool { return r == '.' || r == '-' || r == '_' || r == ' ' }
+	partsA, partsB := strings.FieldsFunc(a, split), strings.FieldsFunc(b, split)
+	for i := 0; i < len(partsA) || i < len(partsB); i++ {
+		// missing parts count as zero, so that 1.2 equals 1.2.0
+		pa, pb := "0", "0"
+		if i < len(partsA) {
+			pa = partsA[i]
+		}
+		if i < len(partsB) {
+			pb = partsB[i]
+		}
+		na, errA := strconv.ParseInt(pa, 10, 64)
+		nb, errB := strconv.ParseInt(pb, 10, 64)
+		switch {
+		case errA == nil && errB == nil && na != nb:
+			if na < nb {
+				return -1
+			}
+			return 1
+		case (errA != nil || errB != nil) && !strings.EqualFold(pa, pb):
+			if strings.ToLower(pa) < strings.ToLower(pb) {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
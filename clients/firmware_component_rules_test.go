@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"terraform-provider-ome/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_CompareFirmwareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"2.19.1", "2.19.1", 0},
+		{"2.9.1", "2.19.1", -1},
+		{"22.5.7", "21.80.11", 1},
+		{"1.2", "1.2.0", 0},
+		{"7.00.60.00", "7.00.30.00", 1},
+		{"A09", "A10", -1},
+		{"20.0.17-A01", "20.0.17-A01", 0},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, CompareFirmwareVersions(tt.a, tt.b), tt.a+" vs "+tt.b)
+	}
+}
+
+func TestClient_ValidateFirmwareComponentRules(t *testing.T) {
+	assert.Nil(t, ValidateFirmwareComponentRules([]models.FirmwareComponentRule{
+		{Action: FirmwareRulePin, SourceName: "DCIM:INSTALLED#701__NIC.*", Version: "22.5.7"},
+		{Action: FirmwareRuleExclude, ComponentID: "159"},
+	}))
+	tests := []struct {
+		name string
+		rule models.FirmwareComponentRule
+		err  string
+	}{
+		{"No component", models.FirmwareComponentRule{Action: FirmwareRuleExclude}, "exactly one of source_name or component_id"},
+		{"Both components", models.FirmwareComponentRule{Action: FirmwareRuleExclude, SourceName: "BIOS", ComponentID: "159"}, "exactly one of source_name or component_id"},
+		{"Pin without version", models.FirmwareComponentRule{Action: FirmwareRulePin, ComponentID: "159"}, "version is required"},
+		{"Exclude with version", models.FirmwareComponentRule{Action: FirmwareRuleExclude, ComponentID: "159", Version: "1.0"}, "version can not be set"},
+		{"Invalid pattern", models.FirmwareComponentRule{Action: FirmwareRuleExclude, SourceName: "NIC[1"}, "not a valid pattern"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorContains(t, ValidateFirmwareComponentRules([]models.FirmwareComponentRule{tt.rule}), tt.err)
+		})
+	}
+}
+
+func TestClient_ApplyFirmwareComponentRules(t *testing.T) {
+	critical := models.CRITICAL
+	reports := []models.DeviceComplianceReport{
+		{
+			ServiceTag:       "SVCTAG1",
+			ComplianceStatus: &critical,
+			ComponentComplianceReports: []models.ComponentComplianceReport{
+				{SourceName: "DCIM:INSTALLED#701__NIC.Integrated.1-1-1", TargetIdentifier: "101734", CurrentVersion: "21.80.11", Version: "22.5.7", UpdateAction: "UPGRADE", ComplianceStatus: "WARNING"},
+				{SourceName: "DCIM:INSTALLED#304_C_RAID.SL.3-1", TargetIdentifier: "104298", CurrentVersion: "51.16.0-4296", Version: "52.26.0-5179", UpdateAction: "UPGRADE", ComplianceStatus: "CRITICAL"},
+				{SourceName: "DCIM:INSTALLED#741__BIOS.Setup.1-1", TargetIdentifier: "159", CurrentVersion: "2.19.1", Version: "2.19.1", UpdateAction: "EQUAL", ComplianceStatus: "OK"},
+			},
+		},
+		{
+			ServiceTag:       "SVCTAG2",
+			ComplianceStatus: &critical,
+			ComponentComplianceReports: []models.ComponentComplianceReport{
+				{SourceName: "DCIM:INSTALLED#iDRAC.Embedded.1-1#IDRACinfo", TargetIdentifier: "25227", CurrentVersion: "6.10.00.00", Version: "7.00.60.00", UpdateAction: "UPGRADE", ComplianceStatus: "CRITICAL"},
+			},
+		},
+	}
+	ApplyFirmwareComponentRules(reports, []models.FirmwareComponentRule{
+		{Action: FirmwareRulePin, SourceName: "dcim:installed#701__nic.*", Version: "21.80.11"},
+		{Action: FirmwareRuleExclude, ComponentID: "104298"},
+		{Action: FirmwareRulePin, ComponentID: "159", Version: "2.21.2"},
+	})
+
+	components := reports[0].ComponentComplianceReports
+	assert.Equal(t, "21.80.11", components[0].Version)
+	assert.Equal(t, FirmwareUpdateActionEqual, components[0].UpdateAction)
+	assert.Equal(t, "OK", components[0].ComplianceStatus)
+	assert.Equal(t, "52.26.0-5179", components[1].Version)
+	assert.Equal(t, FirmwareUpdateActionExcluded, components[1].UpdateAction)
+	assert.Equal(t, "OK", components[1].ComplianceStatus)
+	assert.Equal(t, "2.21.2", components[2].Version)
+	assert.Equal(t, FirmwareUpdateActionUpgrade, components[2].UpdateAction)
+	assert.Equal(t, "WARNING", components[2].ComplianceStatus)
+	assert.Equal(t, models.WARNING, *reports[0].ComplianceStatus)
+	// devices no rule matched keep the compliance computed by OME
+	assert.Equal(t, "UPGRADE", reports[1].ComponentComplianceReports[0].UpdateAction)
+	assert.Equal(t, models.CRITICAL, *reports[1].ComplianceStatus)
+}
+
+func TestClient_ApplyDeviceFirmwareComponentRules(t *testing.T) {
+	baselines := []models.FirmwareBaselinesGetModel{
+		{
+			Name: "baseline1",
+			DeviceComplianceReport: []models.DeviceComplianceModel{
+				{
+					ServiceTag:       "SVCTAG1",
+					ComplianceStatus: "CRITICAL",
+					ComponentComplianceReports: []models.ComponentComplianceReportModel{
+						{SourceName: "DCIM:INSTALLED#741__BIOS.Setup.1-1", TargetIdentifier: "159", CurrentVersion: "2.21.2", Version: "2.19.1", UpdateAction: "DOWNGRADE", ComplianceStatus: "DOWNGRADE"},
+						{SourceName: "DCIM:INSTALLED#304_C_RAID.SL.3-1", TargetIdentifier: "104298", CurrentVersion: "51.16.0-4296", Version: "52.26.0-5179", UpdateAction: "UPGRADE", ComplianceStatus: "CRITICAL"},
+					},
+				},
+			},
+		},
+		{
+			Name: "baseline2",
+			DeviceComplianceReport: []models.DeviceComplianceModel{
+				{
+					ServiceTag:       "SVCTAG1",
+					ComplianceStatus: "CRITICAL",
+					ComponentComplianceReports: []models.ComponentComplianceReportModel{
+						{SourceName: "DCIM:INSTALLED#304_C_RAID.SL.3-1", TargetIdentifier: "104298", CurrentVersion: "51.16.0-4296", Version: "52.26.0-5179", UpdateAction: "UPGRADE", ComplianceStatus: "CRITICAL"},
+					},
+				},
+			},
+		},
+	}
+	ApplyDeviceFirmwareComponentRules(baselines, map[string][]models.FirmwareComponentRule{
+		"BASELINE1": {{Action: FirmwareRuleExclude, SourceName: "*RAID*"}},
+	})
+
+	device := baselines[0].DeviceComplianceReport[0]
+	assert.Equal(t, FirmwareUpdateActionExcluded, device.ComponentComplianceReports[1].UpdateAction)
+	assert.Equal(t, "DOWNGRADE", device.ComplianceStatus)
+	assert.Equal(t, "UPGRADE", baselines[1].DeviceComplianceReport[0].ComponentComplianceReports[0].UpdateAction)
+	assert.Equal(t, "CRITICAL", baselines[1].DeviceComplianceReport[0].ComplianceStatus)
+}
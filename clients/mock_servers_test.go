@@ -55,7 +55,7 @@ func createNewTLSServer(t *testing.T) *httptest.Server {
 
 	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Add("Content-Type", "application/json")
-		shouldReturn1 := mockJobsAPI(r, w, &jobRetries) || mockSessionAPIs(r, w) || mockTimeoutAPIs(r, &attemtp, w) || mockDeviceAPIs(r, w) || mockUpdateNetworkConfigAPI(r, w) || mockUnassignProfileAPI(r, w) || mockDeleteProfileAPI(r, w)
+		shouldReturn1 := mockJobsAPI(r, w, &jobRetries) || mockSessionAPIs(r, w) || mockChassisSlotAPIs(r, w) || mockTimeoutAPIs(r, &attemtp, w) || mockDeviceAPIs(r, w) || mockUpdateNetworkConfigAPI(r, w) || mockUnassignProfileAPI(r, w) || mockDeleteProfileAPI(r, w)
 		if shouldReturn1 {
 			return
 		}
@@ -82,7 +82,7 @@ func createNewTLSServer(t *testing.T) *httptest.Server {
 
 		shouldReturn6 := mockBaselineAPIs(r, w) || mockGetBaselineByIDAPI(r, w) ||
 			mockGetBaselineDevComplianceReportByIDAPI(r, w) || mockGetBaselineDevAttrComplianceReportByIDAPI(r, w) || mockGetBaselineByNameAPI(r, w) ||
-			mockImportTemplateAPI(r, w) || mockGroupServiceActionsAPIs(r, w)
+			mockImportTemplateAPI(r, w) || mockExportTemplateAPI(r, w) || mockGroupServiceActionsAPIs(r, w)
 		if shouldReturn6 {
 			return
 		}
@@ -92,7 +92,7 @@ func createNewTLSServer(t *testing.T) *httptest.Server {
 			return
 		}
 
-		shouldReturn8 := mockNetworkSettingAPIs(r, w)
+		shouldReturn8 := mockNetworkSettingAPIs(r, w) || mockJobActionsAPIs(r, w) || mockRepositoryTestConnectionAPI(r, w)
 		if shouldReturn8 {
 			return
 		}
@@ -263,6 +263,38 @@ func mockJobsAPI(r *http.Request, w http.ResponseWriter, jobRetries *int) bool {
 		}
 		return true
 	}
+	if r.URL.Path == "/api/JobService/Jobs(67890)" && r.Method == "GET" {
+		// the previous run is reported till the new run starts
+		*jobRetries++
+		switch *jobRetries {
+		case 1:
+			w.Write([]byte(`{"LastRun": "2024-01-01 10:00:00.000", "LastRunStatus": {"Id": 2060, "Name": "Completed"}}`))
+		case 2:
+			w.Write([]byte(`{"LastRun": "2024-01-02 10:00:00.000", "LastRunStatus": {"Id": 2050, "Name": "Running"}}`))
+		default:
+			*jobRetries = 0
+			w.Write([]byte(`{"LastRun": "2024-01-02 10:00:00.000", "LastRunStatus": {"Id": 2070, "Name": "Failed"}}`))
+		}
+		return true
+	}
+	if r.URL.Path == "/api/JobService/Jobs(67891)" && r.Method == "GET" {
+		// the job is read before it is run again, the previous run is still reported by the first poll
+		*jobRetries++
+		switch *jobRetries {
+		case 1, 2:
+			w.Write([]byte(`{"LastRun": "2024-01-01 10:00:00.000", "LastRunStatus": {"Id": 2070, "Name": "Failed"}}`))
+		case 3:
+			w.Write([]byte(`{"LastRun": "2024-01-02 10:00:00.000", "LastRunStatus": {"Id": 2050, "Name": "Running"}}`))
+		default:
+			*jobRetries = 0
+			w.Write([]byte(`{"LastRun": "2024-01-02 10:00:00.000", "LastRunStatus": {"Id": 2060, "Name": "Completed"}}`))
+		}
+		return true
+	}
+	if (r.URL.Path == "/api/JobService/Jobs(67890)/LastExecutionDetail") && r.Method == "GET" {
+		w.Write([]byte(`{"Value": "LastExecutionDetail Failure of the new run"}`))
+		return true
+	}
 	if r.URL.Path == "/api/JobService/Jobs(56789)" && r.Method == "GET" {
 		w.Write([]byte(buildJobResponse(2050, "Running")))
 		return true
@@ -1016,6 +1048,55 @@ func mockGetTemplateByIDAPIs(r *http.Request, w http.ResponseWriter) bool {
 }
 
 func mockGetIdentityPoolAPIs(r *http.Request, w http.ResponseWriter) bool {
+	if r.URL.Path == fmt.Sprintf(IdentityPoolUsageSetsAPI, 123) && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"value": [
+				{"IdentitySetId": 1, "Name": "Ethernet"},
+				{"IdentitySetId": 2, "Name": "iSCSI"}
+			]
+		}`))
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(IdentityPoolUsageDetailsAPI, 123, 1) && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"value": [
+				{
+					"IdentityType": "Ethernet",
+					"ProfileName": "Profile from template 'Test Template' 00001",
+					"NicIdentifier": "NIC.Mezzanine.1A-1-1",
+					"MacAddress": "04:0A:00:00:00:01",
+					"DeviceInfo": {"Id": 10001, "ServiceTag": "SVCTAG1"}
+				}
+			]
+		}`))
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(IdentityPoolUsageDetailsAPI, 123, 2) && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"value": [
+				{
+					"ProfileName": "Profile from template 'Test Template' 00001",
+					"NicIdentifier": "NIC.Mezzanine.1A-1-1",
+					"MacAddress": "04:0B:00:00:00:01",
+					"Iqn": "iqn.1988-11.com.dell:01:0000000001"
+				}
+			]
+		}`))
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(IdentityPoolUsageSetsAPI, 124) && r.Method == "GET" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{
+			"error": {
+				"code": "Base.1.0.GeneralError",
+				"message": "A general error has occurred. See ExtendedInfo for more information."
+			}
+		}`))
+		return true
+	}
 	if r.URL.Path == IdentityPoolAPI && r.Method == "GET" {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(`{
@@ -1381,6 +1462,37 @@ func mockGetTemplateAttributesAPIs(r *http.Request, w http.ResponseWriter) bool
 			}
 		}`))
 	}
+	if (r.URL.Path == TemplateAPI+"(38)/AttributeDetails") && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"AttributeGroups": [
+			  {
+				"GroupNameId": 2,
+				"DisplayName": "NIC",
+				"SubAttributeGroups": [
+				  {
+					"GroupNameId": 124,
+					"DisplayName": "Port Settings",
+					"SubAttributeGroups": [],
+					"Attributes": [
+					  {"AttributeId": 130, "DisplayName": "Link Speed", "Value": "Auto", "IsIgnored": false}
+					]
+				  },
+				  {
+					"GroupNameId": 125,
+					"DisplayName": "Port Settings",
+					"SubAttributeGroups": [],
+					"Attributes": [
+					  {"AttributeId": 131, "DisplayName": "Link Speed", "Value": "10Gbps", "IsIgnored": false}
+					]
+				  }
+				],
+				"Attributes": []
+			  }
+			]
+		  }`))
+		return true
+	}
 	if (r.URL.Path == TemplateAPI+"(37)/AttributeDetails") && r.Method == "GET" {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(`{`))
@@ -1484,6 +1596,12 @@ var getExpandedGroupResponse []byte
 
 func mockGroupServiceAPIs(r *http.Request, w http.ResponseWriter) bool {
 
+	if (r.URL.Path == fmt.Sprintf(GroupServiceAPI, 1099) || r.URL.Path == fmt.Sprintf(GroupServiceDevicesAPI, 1099)) && r.Method == "GET" {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":"Base.1.0.GeneralError","message":"A general error has occurred. See ExtendedInfo for more information.","@Message.ExtendedInfo":[{"MessageId":"CGRP9013","Message":"Unable to find the group because the group ID is invalid."}]}}`))
+		return true
+	}
+
 	if r.URL.Path == GroupAPI && r.Method == "GET" {
 		if r.URL.RawQuery == "Name=valid_group1" {
 			w.WriteHeader(http.StatusOK)
@@ -2163,6 +2281,63 @@ func mockDeployAPIs(r *http.Request, w http.ResponseWriter) bool {
 	return false
 }
 
+func mockChassisSlotAPIs(r *http.Request, w http.ResponseWriter) bool {
+	if r.URL.Path == DeviceAPI && r.Method == "GET" && strings.Contains(r.URL.RawQuery, "MXCHAS1") {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"value": [{"Id": 123789, "Type": 2000, "DeviceServiceTag": "MXCHAS1"}]}`))
+		return true
+	}
+	if r.URL.Path == DeviceAPI && r.Method == "GET" && strings.Contains(r.URL.RawQuery, "MXSLED1") {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"value": [{"Id": 10001, "Type": 1000, "DeviceServiceTag": "MXSLED1"}]}`))
+		return true
+	}
+	if r.URL.Path == fmt.Sprintf(DeviceInventorySingleAPI, 123789, ChassisSlotsInventoryType) && r.Method == "GET" {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"InventoryType": "chassisSlotsList",
+			"InventoryInfo": [
+				{"Id": 20001, "SlotNumber": "1", "SlotName": "SLED-1", "SlotType": 2000, "DeviceId": 10001},
+				{"Id": 20002, "SlotNumber": "2", "SlotName": "SLED-2", "SlotType": 2000, "DeviceId": 0},
+				{"Id": 20009, "SlotNumber": "9", "SlotName": "IOM-A1", "SlotType": 4000, "DeviceId": 10009}
+			]
+		}`))
+		return true
+	}
+	if r.URL.Path == ProfileAPI && r.Method == "POST" {
+		body, _ := io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+		if strings.Contains(string(body), `"TemplateId":1,`) {
+			w.Write([]byte(`[10079]`))
+		} else {
+			w.Write([]byte(`[]`))
+		}
+		return true
+	}
+	if r.URL.Path == AssignProfileAPI && r.Method == "POST" {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "10079") {
+			w.WriteHeader(http.StatusOK)
+			return true
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{
+			"error": {
+				"code": "Base.1.0.GeneralError",
+				"message": "A general error has occurred. See ExtendedInfo for more information.",
+				"@Message.ExtendedInfo": [
+					{
+						"MessageId": "CTEM9062",
+						"Message": "Unable to assign the profile because the profile is already assigned."
+					}
+				]
+			}
+		}`))
+		return true
+	}
+	return false
+}
+
 func mockUnassignProfileAPI(r *http.Request, w http.ResponseWriter) bool {
 	if (r.URL.Path == UnAssignProfileAPI) && r.Method == "POST" {
 		body, _ := io.ReadAll(r.Body)
@@ -3074,6 +3249,25 @@ func mockImportTemplateAPI(r *http.Request, w http.ResponseWriter) bool {
 	return false
 }
 
+func mockExportTemplateAPI(r *http.Request, w http.ResponseWriter) bool {
+	if (r.URL.Path == ExportTemplateAPI) && r.Method == "POST" {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), fmt.Sprintf(`"TemplateId":%d`, TestDeploymentTemplateID)) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{
+				"TemplateId": ` + fmt.Sprint(TestDeploymentTemplateID) + `,
+				"ContentType": "xml",
+				"Content": "<SystemConfiguration><Component FQDD=\"BIOS.Setup.1-1\"><Attribute Name=\"BootMode\">Uefi</Attribute></Component></SystemConfiguration>"
+			}`))
+		} else {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`invalid template export`))
+		}
+		return true
+	}
+	return false
+}
+
 func mockDiscoveryAPIs(r *http.Request, w http.ResponseWriter) bool {
 	if (r.URL.Path == DiscoveryJobAPI) && r.Method == "POST" {
 		body, _ := io.ReadAll(r.Body)
@@ -3205,3 +3399,51 @@ func mockNetworkSettingAPIs(r *http.Request, w http.ResponseWriter) bool {
 	}
 	return false
 }
+
+func mockJobActionsAPIs(r *http.Request, w http.ResponseWriter) bool {
+	if (r.URL.Path == RunJobsAPI || r.URL.Path == RefreshCatalogsAPI) && r.Method == "POST" {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "-1") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`invalid job action`))
+			return true
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return true
+	}
+	return false
+}
+
+func mockRepositoryTestConnectionAPI(r *http.Request, w http.ResponseWriter) bool {
+	if r.URL.Path != RepositoryTestConnectionAPI || r.Method != "POST" {
+		return false
+	}
+	body, _ := io.ReadAll(r.Body)
+	if strings.Contains(string(body), "unreachable") {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{
+			"error": {
+				"code": "Base.1.0.GeneralError",
+				"message": "A general error has occurred. See ExtendedInfo for more information.",
+				"@Message.ExtendedInfo": [
+					{
+						"MessageId": "CUPD3105",
+						"RelatedProperties": [],
+						"Message": "Unable to connect to the share because the share address is not reachable.",
+						"MessageArgs": [],
+						"Severity": "Critical",
+						"Resolution": "Make sure the share address is reachable from the appliance and retry the operation."
+					}
+				]
+			}
+		}`))
+		return true
+	}
+	if strings.Contains(string(body), "no-json") {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`internal error`))
+		return true
+	}
+	w.WriteHeader(http.StatusOK)
+	return true
+}
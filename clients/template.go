@@ -20,22 +20,13 @@ import (
 	"strconv"
 	"strings"
 	"terraform-provider-ome/models"
+	"terraform-provider-ome/utils"
+	"unicode"
 )
 
 // GetTemplateAttributes returns the editable attributes for a given templateID
 func (c *Client) GetTemplateAttributes(templateID int64, stateAttributes []models.Attribute, refreshAll bool) ([]models.OmeAttribute, error) {
-	attributesResp, err := c.Get(fmt.Sprintf(TemplateAPI+"(%d)/%s", templateID, "AttributeDetails"), nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	respBody := attributesResp.Body
-	attributeRespBody, _ := io.ReadAll(respBody)
-	err = respBody.Close()
-	if err != nil {
-		return nil, err
-	}
-	attrGroups := models.OMETemplateAttrGroups{}
-	err = c.JSONUnMarshal(attributeRespBody, &attrGroups)
+	attrGroups, err := c.getTemplateAttrGroups(templateID)
 	if err != nil {
 		return nil, err
 	}
@@ -64,6 +55,106 @@ func (c *Client) GetTemplateAttributes(templateID int64, stateAttributes []model
 	return omeAttributes, nil
 }
 
+// GetTemplateAttributesByPath returns the attributes of a template for the given display name paths,
+// formed by the names of the attribute groups and the name of the attribute separated by commas
+func (c *Client) GetTemplateAttributesByPath(templateID int64, paths []string) (map[string]models.OmeAttribute, error) {
+	attrGroups, err := c.getTemplateAttrGroups(templateID)
+	if err != nil {
+		return nil, err
+	}
+	attributes := map[string]models.OmeAttribute{}
+	errs := []string{}
+	for _, path := range paths {
+		attribute, err := findAttributeByPath(path, attrGroups.AttributeGroups)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		attributes[path] = attribute
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("unknown template attributes: %s", strings.Join(errs, "; "))
+	}
+	return attributes, nil
+}
+
+func (c *Client) getTemplateAttrGroups(templateID int64) (models.OMETemplateAttrGroups, error) {
+	attrGroups := models.OMETemplateAttrGroups{}
+	attributesResp, err := c.Get(fmt.Sprintf(TemplateAPI+"(%d)/%s", templateID, "AttributeDetails"), nil, nil)
+	if err != nil {
+		return attrGroups, err
+	}
+	respBody := attributesResp.Body
+	attributeRespBody, _ := io.ReadAll(respBody)
+	err = respBody.Close()
+	if err != nil {
+		return attrGroups, err
+	}
+	err = c.JSONUnMarshal(attributeRespBody, &attrGroups)
+	return attrGroups, err
+}
+
+func findAttributeByPath(displayName string, attrGroups []models.AttributeGroup) (models.OmeAttribute, error) {
+	attributeHierarchy := strings.Split(displayName, ",")
+	if len(attributeHierarchy) < 3 {
+		return models.OmeAttribute{}, fmt.Errorf("%s is not a path made of a group, sub groups and an attribute", displayName)
+	}
+	matches := []models.OmeAttribute{}
+	for _, attrGroup := range attrGroups {
+		if attrGroup.DisplayName == attributeHierarchy[0] {
+			matches = append(matches, collectAttributesByPath(attributeHierarchy[1:], attrGroup.SubAttributeGroups)...)
+		}
+	}
+	if len(matches) > 1 {
+		candidates := make([]string, 0, len(matches))
+		for _, match := range matches {
+			candidates = append(candidates, fmt.Sprintf("%d (%s)", match.AttributeID, match.Value))
+		}
+		return models.OmeAttribute{}, fmt.Errorf("%s matches several attributes of the template: %s", displayName, strings.Join(candidates, ", "))
+	}
+	err := fmt.Errorf("attribute group %s of %s could not be found", attributeHierarchy[0], displayName)
+	for _, attrGroup := range attrGroups {
+		if attrGroup.DisplayName != attributeHierarchy[0] {
+			continue
+		}
+		counter := 1
+		subAttrGroup, subErr := findSubAttrGroup(displayName, &counter, attrGroup.SubAttributeGroups)
+		if subErr != nil {
+			err = fmt.Errorf("attribute group %s of %s could not be found", strings.Join(attributeHierarchy[:counter+1], ","), displayName)
+			continue
+		}
+		for _, attribute := range subAttrGroup.Attributes {
+			if attribute.DisplayName == attributeHierarchy[counter] {
+				attribute.DisplayName = displayName
+				return attribute, nil
+			}
+		}
+		err = fmt.Errorf("attribute %s could not be found in %s", attributeHierarchy[counter], strings.Join(attributeHierarchy[:counter], ","))
+	}
+	return models.OmeAttribute{}, err
+}
+
+// collectAttributesByPath returns every attribute of the sub groups reached by the remaining path,
+// sub groups sharing a display name are all walked
+func collectAttributesByPath(hierarchy []string, subAttrGroups []models.SubAttributeGroup) []models.OmeAttribute {
+	matches := []models.OmeAttribute{}
+	for _, subAttrGroup := range subAttrGroups {
+		if subAttrGroup.DisplayName != hierarchy[0] {
+			continue
+		}
+		if len(hierarchy) > 2 {
+			matches = append(matches, collectAttributesByPath(hierarchy[1:], subAttrGroup.SubAttributeGroups)...)
+			continue
+		}
+		for _, attribute := range subAttrGroup.Attributes {
+			if attribute.DisplayName == hierarchy[1] {
+				matches = append(matches, attribute)
+			}
+		}
+	}
+	return matches
+}
+
 func findSubAttrGroup(displayName string, counter *int, subAttrGroups []models.SubAttributeGroup) (models.SubAttributeGroup, error) {
 	attributeHierarchy := strings.Split(displayName, ",")
 	for _, subAttrGroup := range subAttrGroups {
@@ -250,6 +341,15 @@ func (c *Client) GetTemplateByName(name string) (models.OMETemplate, error) {
 	return models.OMETemplate{}, nil
 }
 
+// GetAllTemplates returns all the templates available in the appliance
+func (c *Client) GetAllTemplates() ([]models.OMETemplate, error) {
+	omeTemplates := []models.OMETemplate{}
+	err := c.GetValueWithPagination(RequestOptions{
+		URL: TemplateAPI,
+	}, &omeTemplates)
+	return omeTemplates, err
+}
+
 // UpdateTemplate updates a template from a reference template id.
 func (c *Client) UpdateTemplate(ut models.UpdateTemplate) error {
 	data, errMarshal := c.JSONMarshal(ut)
@@ -456,6 +556,178 @@ func (c *Client) ImportTemplate(importTemplateRequest models.OMEImportTemplate)
 	return newTemplateID, nil
 }
 
+// ExportTemplate - method to export the XML content of a template.
+func (c *Client) ExportTemplate(templateID int64) (string, error) {
+	data, errMarshal := c.JSONMarshal(models.OMEExportTemplate{
+		TemplateID: templateID,
+		Format:     "xml",
+	})
+	if errMarshal != nil {
+		return "", errMarshal
+	}
+	response, err := c.Post(ExportTemplateAPI, nil, data)
+	if err != nil {
+		return "", err
+	}
+	exported := models.OMEExportTemplateResponse{}
+	if err := parseResponse(c, response, &exported); err != nil {
+		return "", err
+	}
+	return exported.Content, nil
+}
+
+// GetTemplateContentUpdates - method to get the attribute updates applying the value changes of a template content to the template.
+// The changed attributes of the content are matched to the attributes of the template AttributeDetails, the changed attributes
+// matching no attribute or several attributes of the template are returned as unmatched.
+func (c *Client) GetTemplateContentUpdates(templateID int64, changes []utils.SCPAttributeChange) ([]models.UpdateAttribute, []string, error) {
+	if len(changes) == 0 {
+		return []models.UpdateAttribute{}, []string{}, nil
+	}
+	templateAttributes, err := c.GetTemplateAttributes(templateID, nil, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	updates, unmatched := getContentUpdates(templateAttributes, changes)
+	return updates, unmatched, nil
+}
+
+// getContentUpdates matches every changed attribute of the content to one attribute of the template.
+// The display path of the attribute must hold the FQDD of the component, or start with its type, the part of the FQDD before the first dot,
+// and end with the name of the attribute. Spaces and punctuation are ignored in the names, the instance of grouped attributes such as
+// Users.2#UserName must end one of the groups of the path when several attributes match.
+// The SCP names are not part of the AttributeDetails, the changes which can not be matched are described in the unmatched list.
+func getContentUpdates(templateAttributes []models.OmeAttribute, changes []utils.SCPAttributeChange) ([]models.UpdateAttribute, []string) {
+	updates := []models.UpdateAttribute{}
+	unmatched := []string{}
+	for _, change := range changes {
+		candidates := matchContentAttribute(templateAttributes, change.Key)
+		switch len(candidates) {
+		case 0:
+			unmatched = append(unmatched, fmt.Sprintf("attribute %s of the content does not exist in the template", change.Key))
+		case 1:
+			updates = append(updates, models.UpdateAttribute{
+				ID:    candidates[0].AttributeID,
+				Value: change.NewValue,
+			})
+		default:
+			names := make([]string, 0, len(candidates))
+			for _, candidate := range candidates {
+				names = append(names, candidate.DisplayName)
+			}
+			unmatched = append(unmatched, fmt.Sprintf("attribute %s of the content matches several attributes of the template: %s", change.Key, strings.Join(names, "; ")))
+		}
+	}
+	return updates, unmatched
+}
+
+// matchContentAttribute returns the attributes of the template matching the SCP attribute key, the most specific matches only
+func matchContentAttribute(templateAttributes []models.OmeAttribute, key string) []models.OmeAttribute {
+	fqdd, name := utils.SplitSCPAttributeKey(key)
+	componentType, _, _ := strings.Cut(fqdd, ".")
+	group, attributeName, grouped := strings.Cut(name, "#")
+	if !grouped {
+		attributeName, group = name, ""
+	}
+	byFQDD, byType := []models.OmeAttribute{}, []models.OmeAttribute{}
+	for _, attribute := range templateAttributes {
+		path := strings.Split(attribute.DisplayName, ",")
+		if contentAttributeName(path[len(path)-1]) != contentAttributeName(attributeName) {
+			continue
+		}
+		if utils.ContainsFold(path[:len(path)-1], fqdd) {
+			byFQDD = append(byFQDD, attribute)
+		} else if strings.EqualFold(path[0], componentType) {
+			byType = append(byType, attribute)
+		}
+	}
+	candidates := byFQDD
+	if len(candidates) == 0 {
+		candidates = byType
+	}
+	if len(candidates) < 2 || group == "" {
+		return candidates
+	}
+	// Users.2#UserName is held by a group such as User 2
+	instance := group[strings.LastIndex(group, ".")+1:]
+	instances := []models.OmeAttribute{}
+	for _, candidate := range candidates {
+		path := strings.Split(candidate.DisplayName, ",")
+		for _, pathGroup := range path[1 : len(path)-1] {
+			if strings.HasSuffix(pathGroup, " "+instance) {
+				instances = append(instances, candidate)
+				break
+			}
+		}
+	}
+	if len(instances) == 0 {
+		return candidates
+	}
+	return instances
+}
+
+// contentAttributeName returns the name in lower case without spaces and punctuation
+func contentAttributeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
+
+// DiffTemplateAttributes returns the attributes added, removed and changed from the source attributes to the target attributes.
+// Attributes are matched by display name, in order when several attributes share the same display name.
+// Ignored attributes are skipped unless includeIgnored is set.
+func DiffTemplateAttributes(sourceAttributes, targetAttributes []models.OmeAttribute, includeIgnored bool) models.TemplateAttributesDiff {
+	diff := models.TemplateAttributesDiff{
+		Added:   []models.TemplateAttributeDifference{},
+		Removed: []models.TemplateAttributeDifference{},
+		Changed: []models.TemplateAttributeDifference{},
+	}
+	sourceByName := map[string][]models.OmeAttribute{}
+	for _, attribute := range sourceAttributes {
+		sourceByName[attribute.DisplayName] = append(sourceByName[attribute.DisplayName], attribute)
+	}
+	for _, target := range targetAttributes {
+		candidates := sourceByName[target.DisplayName]
+		if len(candidates) == 0 {
+			if includeIgnored || !target.IsIgnored {
+				diff.Added = append(diff.Added, models.TemplateAttributeDifference{
+					DisplayName: target.DisplayName,
+					TargetValue: target.Value,
+				})
+			}
+			continue
+		}
+		source := candidates[0]
+		sourceByName[target.DisplayName] = candidates[1:]
+		if !includeIgnored && (source.IsIgnored || target.IsIgnored) {
+			continue
+		}
+		if source.Value != target.Value {
+			diff.Changed = append(diff.Changed, models.TemplateAttributeDifference{
+				DisplayName: target.DisplayName,
+				SourceValue: source.Value,
+				TargetValue: target.Value,
+			})
+		}
+	}
+	for _, source := range sourceAttributes {
+		remaining := sourceByName[source.DisplayName]
+		if len(remaining) == 0 || remaining[0] != source {
+			continue
+		}
+		sourceByName[source.DisplayName] = remaining[1:]
+		if includeIgnored || !source.IsIgnored {
+			diff.Removed = append(diff.Removed, models.TemplateAttributeDifference{
+				DisplayName: source.DisplayName,
+				SourceValue: source.Value,
+			})
+		}
+	}
+	return diff
+}
+
 func getAllVlanAttributes(nags []models.NetworkAttributeGroup) []models.OMEVlanAttribute {
 	vlanAttrs := []models.OMEVlanAttribute{}
 	for _, nicIdentifier := range nags { // Loops NIC identifiers
@@ -17,6 +17,7 @@ import (
 	"reflect"
 
 	"terraform-provider-ome/models"
+	"terraform-provider-ome/utils"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -1067,3 +1068,187 @@ func TestClient_ImportTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_ExportTemplate(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+
+	c, _ := NewClient(opts)
+
+	content, err := c.ExportTemplate(TestDeploymentTemplateID)
+	assert.Nil(t, err)
+	assert.Contains(t, content, `<Attribute Name="BootMode">Uefi</Attribute>`)
+
+	_, err = c.ExportTemplate(-1)
+	assert.NotNil(t, err)
+}
+
+func TestClient_GetTemplateContentUpdates(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+
+	c, _ := NewClient(opts)
+
+	changes, unmatched, err := c.GetTemplateContentUpdates(31, []utils.SCPAttributeChange{
+		{Key: "BIOS.Setup.1-1/BootSequence", OldValue: "HardDisk.List.1-1", NewValue: "NIC.PxeDevice.1-1"},
+		{Key: "NIC.Integrated.1-1-1/BootToTarget", OldValue: "Enabled", NewValue: "Disabled"},
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, unmatched)
+	assert.Equal(t, []models.UpdateAttribute{
+		{ID: 110, Value: "NIC.PxeDevice.1-1"},
+		{ID: 120, Value: "Disabled"},
+	}, changes)
+
+	// no change, the template attributes are not read
+	changes, unmatched, err = c.GetTemplateContentUpdates(36, []utils.SCPAttributeChange{})
+	assert.Nil(t, err)
+	assert.Empty(t, changes)
+	assert.Empty(t, unmatched)
+
+	_, _, err = c.GetTemplateContentUpdates(36, []utils.SCPAttributeChange{
+		{Key: "BIOS.Setup.1-1/BootSequence", NewValue: "NIC.PxeDevice.1-1"},
+	})
+	assert.NotNil(t, err)
+}
+
+func TestGetContentUpdates(t *testing.T) {
+	templateAttributes := []models.OmeAttribute{
+		{AttributeID: 1, DisplayName: "NIC,NIC.Integrated.1-1-1,Boot Protocol", Value: "PXE"},
+		{AttributeID: 2, DisplayName: "NIC,NIC.Integrated.1-2-1,Boot Protocol", Value: "PXE"},
+		{AttributeID: 3, DisplayName: "BIOS,Boot Settings,Boot Mode", Value: "Uefi", IsIgnored: true},
+		{AttributeID: 4, DisplayName: "iDRAC,Users,User 2,User Name", Value: "root"},
+		{AttributeID: 5, DisplayName: "iDRAC,Users,User 3,User Name", Value: "admin"},
+		{AttributeID: 6, DisplayName: "NIC,Port Settings,Link Speed", Value: "Auto"},
+		{AttributeID: 7, DisplayName: "NIC,Partition Settings,Link Speed", Value: "Auto"},
+	}
+	changes, unmatched := getContentUpdates(templateAttributes, []utils.SCPAttributeChange{
+		{Key: "NIC.Integrated.1-2-1/BootProto", NewValue: "NONE"},
+		{Key: "NIC.Integrated.1-1-1/Boot_Protocol", NewValue: "NONE"},
+		{Key: "BIOS.Setup.1-1/BootMode", NewValue: "Bios"},
+		{Key: "iDRAC.Embedded.1/Users.3#UserName", NewValue: "operator"},
+	})
+	assert.Equal(t, []string{"attribute NIC.Integrated.1-2-1/BootProto of the content does not exist in the template"}, unmatched)
+	assert.Len(t, changes, 3)
+
+	changes, unmatched = getContentUpdates(templateAttributes, []utils.SCPAttributeChange{
+		{Key: "NIC.Integrated.1-1-1/Boot_Protocol", NewValue: "NONE"},
+		{Key: "BIOS.Setup.1-1/BootMode", NewValue: "Bios"},
+		{Key: "iDRAC.Embedded.1/Users.3#UserName", NewValue: "operator"},
+	})
+	assert.Empty(t, unmatched)
+	assert.Equal(t, []models.UpdateAttribute{
+		{ID: 1, Value: "NONE"},
+		{ID: 3, Value: "Bios"},
+		{ID: 5, Value: "operator"},
+	}, changes)
+
+	_, unmatched = getContentUpdates(templateAttributes, []utils.SCPAttributeChange{
+		{Key: "NIC.Integrated.1-1-1/LinkSpeed", NewValue: "10Gbps"},
+	})
+	assert.Len(t, unmatched, 1)
+	assert.Contains(t, unmatched[0], "matches several attributes of the template: NIC,Port Settings,Link Speed; NIC,Partition Settings,Link Speed")
+}
+
+func TestGetContentUpdatesDisplayNames(t *testing.T) {
+	// display names of the AttributeDetails of a template created from a PowerEdge server
+	templateAttributes := []models.OmeAttribute{
+		{AttributeID: 1197, DisplayName: "BIOS,Boot Settings,Boot Mode", Value: "Uefi"},
+		{AttributeID: 1302, DisplayName: "BIOS,System Profile Settings,System Profile", Value: "PerfPerWattOptimizedDapc"},
+		{AttributeID: 1412, DisplayName: "BIOS,Processor Settings,Logical Processor", Value: "Enabled"},
+		{AttributeID: 2287, DisplayName: "iDRAC,Time Zone Configuration Information,Time 1 Time Zone String", Value: "CST6CDT"},
+		{AttributeID: 2310, DisplayName: "iDRAC,NIC Information,NIC 1 DNS RAC Name", Value: "idrac-host"},
+	}
+	changes, unmatched := getContentUpdates(templateAttributes, []utils.SCPAttributeChange{
+		{Key: "BIOS.Setup.1-1/BootMode", NewValue: "Bios"},
+		{Key: "BIOS.Setup.1-1/LogicalProc", NewValue: "Disabled"},
+		{Key: "BIOS.Setup.1-1/SysProfile", NewValue: "PerfOptimized"},
+		{Key: "iDRAC.Embedded.1/Time.1#Timezone", NewValue: "UTC"},
+		{Key: "iDRAC.Embedded.1/NIC.1#DNSRacName", NewValue: "idrac-renamed"},
+	})
+	// the SCP names which are not the display names can not be matched, the template must then be replaced
+	assert.Equal(t, []models.UpdateAttribute{{ID: 1197, Value: "Bios"}}, changes)
+	assert.Equal(t, []string{
+		"attribute BIOS.Setup.1-1/LogicalProc of the content does not exist in the template",
+		"attribute BIOS.Setup.1-1/SysProfile of the content does not exist in the template",
+		"attribute iDRAC.Embedded.1/Time.1#Timezone of the content does not exist in the template",
+		"attribute iDRAC.Embedded.1/NIC.1#DNSRacName of the content does not exist in the template",
+	}, unmatched)
+}
+
+func TestClient_GetTemplateAttributesByPath(t *testing.T) {
+	ts := createNewTLSServer(t)
+	defer ts.Close()
+
+	opts := initOptions(ts)
+
+	c, _ := NewClient(opts)
+
+	attributes, err := c.GetTemplateAttributesByPath(31, []string{
+		"BIOS,BIOS Boot Settings,Boot Sequence",
+		"NIC,NIC.Integrated.1-1-1,iSCSI General Parameters,Boot to Target",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(110), attributes["BIOS,BIOS Boot Settings,Boot Sequence"].AttributeID)
+	assert.Equal(t, "Enabled", attributes["NIC,NIC.Integrated.1-1-1,iSCSI General Parameters,Boot to Target"].Value)
+
+	_, err = c.GetTemplateAttributesByPath(31, []string{
+		"BIOS,Boot Sequence",
+		"iDRAC,Time Zone Configuration Information,Time Zone String 1",
+		"BIOS,BIOS Settings,Boot Sequence",
+		"BIOS,BIOS Boot Settings,Boot Mode",
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "BIOS,Boot Sequence is not a path")
+	assert.Contains(t, err.Error(), "attribute group iDRAC of iDRAC,Time Zone Configuration Information,Time Zone String 1 could not be found")
+	assert.Contains(t, err.Error(), "attribute group BIOS,BIOS Settings of BIOS,BIOS Settings,Boot Sequence could not be found")
+	assert.Contains(t, err.Error(), "attribute Boot Mode could not be found in BIOS,BIOS Boot Settings")
+
+	_, err = c.GetTemplateAttributesByPath(36, []string{"BIOS,BIOS Boot Settings,Boot Sequence"})
+	assert.NotNil(t, err)
+
+	_, err = c.GetTemplateAttributesByPath(38, []string{"NIC,Port Settings,Link Speed"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "NIC,Port Settings,Link Speed matches several attributes of the template: 130 (Auto), 131 (10Gbps)")
+}
+
+func TestDiffTemplateAttributes(t *testing.T) {
+	source := []models.OmeAttribute{
+		{AttributeID: 1, DisplayName: "BIOS,Boot Settings,Boot Mode", Value: "Uefi"},
+		{AttributeID: 2, DisplayName: "NIC,NIC.Integrated.1-1-1,Boot Protocol", Value: "PXE"},
+		{AttributeID: 3, DisplayName: "NIC,NIC.Integrated.1-1-1,Boot Protocol", Value: "PXE"},
+		{AttributeID: 4, DisplayName: "iDRAC,Time Zone Configuration Information,Time Zone String 1", Value: "UTC", IsIgnored: true},
+		{AttributeID: 5, DisplayName: "System,Server Topology,Aisle Name", Value: "A1"},
+	}
+	target := []models.OmeAttribute{
+		{AttributeID: 11, DisplayName: "BIOS,Boot Settings,Boot Mode", Value: "Bios"},
+		{AttributeID: 12, DisplayName: "NIC,NIC.Integrated.1-1-1,Boot Protocol", Value: "PXE"},
+		{AttributeID: 13, DisplayName: "iDRAC,Time Zone Configuration Information,Time Zone String 1", Value: "IST"},
+		{AttributeID: 14, DisplayName: "BIOS,Boot Settings,Boot Sequence Retry", Value: "Enabled"},
+	}
+
+	diff := DiffTemplateAttributes(source, target, false)
+	assert.Equal(t, []models.TemplateAttributeDifference{
+		{DisplayName: "BIOS,Boot Settings,Boot Sequence Retry", TargetValue: "Enabled"},
+	}, diff.Added)
+	assert.Equal(t, []models.TemplateAttributeDifference{
+		{DisplayName: "NIC,NIC.Integrated.1-1-1,Boot Protocol", SourceValue: "PXE"},
+		{DisplayName: "System,Server Topology,Aisle Name", SourceValue: "A1"},
+	}, diff.Removed)
+	assert.Equal(t, []models.TemplateAttributeDifference{
+		{DisplayName: "BIOS,Boot Settings,Boot Mode", SourceValue: "Uefi", TargetValue: "Bios"},
+	}, diff.Changed)
+
+	diff = DiffTemplateAttributes(source, target, true)
+	assert.Len(t, diff.Changed, 2)
+	assert.Equal(t, "IST", diff.Changed[1].TargetValue)
+
+	diff = DiffTemplateAttributes(source, source, true)
+	assert.Empty(t, diff.Added)
+	assert.Empty(t, diff.Removed)
+	assert.Empty(t, diff.Changed)
+}
@@ -5,6 +5,7 @@ import (
 	"terraform-provider-ome/models"
 )
 
+// GetUplinkByName returns the uplink of the fabric with the given name, ErrItemNotFound is returned when no uplink matches
 func (c *Client) GetUplinkByName(fabricID string, name string) (models.OMEUplink, error) {
 	omeUplinkResponse := []models.OMEUplink{}
 	// err := c.GetPaginatedDataWithQueryParam(fmt.Sprintf(UplinkAPI, fabricID), nil, &omeUplinkResponse)
@@ -13,15 +14,12 @@ func (c *Client) GetUplinkByName(fabricID string, name string) (models.OMEUplink
 	if err != nil {
 		return models.OMEUplink{}, err
 	}
-	if len(omeUplinkResponse) == 0 {
-		return models.OMEUplink{}, nil
-	}
 	for _, u := range omeUplinkResponse {
 		if u.Name == name {
 			return u, nil
 		}
 	}
-	return models.OMEUplink{}, nil
+	return models.OMEUplink{}, fmt.Errorf("uplink %s could not be found in fabric %s: %w", name, fabricID, ErrItemNotFound)
 }
 
 func (c *Client) GetUplinkPorts(fabricID string, uplinkID string) (models.OMEUplinkPorts, error) {
@@ -86,3 +86,12 @@ func (c *Client) GetUserByID(id string) (models.User, error) {
 	fmt.Println(string(respData))
 	return omeUser, err
 }
+
+// GetAllUsers - to get all the users
+func (c *Client) GetAllUsers() ([]models.User, error) {
+	omeUsers := []models.User{}
+	err := c.GetValueWithPagination(RequestOptions{
+		URL: UserAPI,
+	}, &omeUsers)
+	return omeUsers, err
+}
@@ -0,0 +1,39 @@
+# /*
+# Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#     http://mozilla.org/MPL/2.0/
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# */
+
+# Re-check the compliance of a configuration baseline
+action "ome_compliance_check" "configuration" {
+  config {
+    configuration_baseline_name = "baseline-r650"
+  }
+}
+
+# Re-check the compliance of a firmware baseline
+action "ome_compliance_check" "firmware" {
+  config {
+    firmware_baseline_name = "firmware-baseline-r650"
+    timeout                = 20
+  }
+}
+
+# Re-check the firmware compliance every time the catalog is updated
+resource "terraform_data" "catalog" {
+  input = ome_firmware_catalog.catalog.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.ome_compliance_check.firmware]
+    }
+  }
+}
@@ -0,0 +1,33 @@
+# /*
+# Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#     http://mozilla.org/MPL/2.0/
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# */
+
+# Refresh the inventory of devices on demand
+action "ome_device_inventory_refresh" "refresh" {
+  config {
+    device_service_tags = ["CZMC1T2", "4111H63"]
+    job_name            = "inventory-refresh-job"
+    timeout             = 8
+  }
+}
+
+# Refresh the inventory after the devices have been discovered
+resource "terraform_data" "discovered" {
+  input = ome_discovery.discover.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.ome_device_inventory_refresh.refresh]
+    }
+  }
+}
@@ -0,0 +1,22 @@
+# /*
+# Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#     http://mozilla.org/MPL/2.0/
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# */
+
+# Gracefully shut down devices
+# Accepted power states are on, off, graceful_shutdown, power_cycle and reset
+# Run it with: terraform apply -invoke=action.ome_device_power.shutdown
+action "ome_device_power" "shutdown" {
+  config {
+    device_ids  = [10104, 10112]
+    power_state = "graceful_shutdown"
+  }
+}
@@ -0,0 +1,36 @@
+# /*
+# Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#     http://mozilla.org/MPL/2.0/
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# */
+
+
+# List the components of a downloaded catalog for the R650 servers, before importing it into OME
+data "ome_catalog_contents" "r650" {
+  path   = "${path.module}/Catalog.xml.gz"
+  models = ["R650"]
+}
+
+output "r650_component_versions" {
+  value = { for component in data.ome_catalog_contents.r650.components : component.name => component.version }
+}
+
+# Urgent updates of a catalog, by system ID, which require a reboot
+data "ome_catalog_contents" "urgent" {
+  path       = "file:///opt/catalogs/Catalog.xml"
+  system_ids = ["0A6B", "0715"]
+}
+
+output "urgent_reboot_updates" {
+  value = [
+    for component in data.ome_catalog_contents.urgent.components : component.path
+    if component.criticality == "Urgent" && component.reboot_required
+  ]
+}
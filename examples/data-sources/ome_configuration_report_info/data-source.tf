@@ -14,4 +14,30 @@ limitations under the License.
 # Get configuration compliance report for a baseline
 data "ome_configuration_report_info" "cr" {
   baseline_name = "BaselineName"
+}
+
+# Run the compliance task of the baseline before reading the report, so that the report reflects the current state of the devices
+data "ome_configuration_report_info" "cr_fresh" {
+  baseline_name      = "BaselineName"
+  refresh_compliance = true
+  refresh_timeout    = 20
+}
+
+# Get the non compliant BIOS and iDRAC attributes of a device
+data "ome_configuration_report_info" "cr_bios" {
+  baseline_name      = "BaselineName"
+  fetch_attributes   = true
+  non_compliant_only = true
+  components         = ["BIOS", "iDRAC"]
+  device_servicetags = ["MXL1234"]
+}
+
+output "non_compliant_attributes" {
+  value = flatten([
+    for device in data.ome_configuration_report_info.cr_bios.compliance_report_device : [
+      for component in device.compliance_components : [
+        for attribute in component.attributes : "${device.device_servicetag}: ${component.component} ${attribute.attribute_name} is ${attribute.current_value}, expected ${attribute.expected_value}"
+      ]
+    ]
+  ])
 }
\ No newline at end of file
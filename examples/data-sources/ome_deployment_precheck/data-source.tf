@@ -0,0 +1,42 @@
+# /*
+# Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#     http://mozilla.org/MPL/2.0/
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# */
+
+# Pre-check the deployment of a template on devices
+data "ome_deployment_precheck" "bios" {
+  template_name      = "web-tier R650"
+  device_servicetags = ["CZMC1T2", "CZNF1T2"]
+}
+
+output "precheck_failures" {
+  value = {
+    for device in data.ome_deployment_precheck.bios.devices :
+    device.service_tag => device.attribute_messages if !device.passed
+  }
+}
+
+# Deploy the template only when the pre-check passed without VLAN conflicts
+resource "ome_deployment" "bios" {
+  template_name      = "web-tier R650"
+  device_servicetags = ["CZMC1T2", "CZNF1T2"]
+
+  lifecycle {
+    precondition {
+      condition     = data.ome_deployment_precheck.bios.passed
+      error_message = "The deployment pre-check failed, see the precheck_failures output."
+    }
+    precondition {
+      condition     = alltrue([for device in data.ome_deployment_precheck.bios.devices : length(device.vlan_conflicts) == 0])
+      error_message = "The template VLANs conflict with the network of the devices."
+    }
+  }
+}
@@ -18,6 +18,15 @@ data "ome_device_compliance_report" "device_compliance_report_data" {
   # device_ids = [10102]
   # device_service_tags = ["HRPB0M3"]
   # device_group_names = ["Servers"]
+
+  // Component rules of firmware baselines, excluded components are reported with the update action EXCLUDED
+  // and pinned components are compared against their pinned version.
+  # baseline_component_rules = [
+  #   {
+  #     baseline_name   = ome_firmware_baseline.firmware_baseline.name
+  #     component_rules = ome_firmware_baseline.firmware_baseline.component_rules
+  #   }
+  # ]
 }
 
 output "device_compliance_report_data" {
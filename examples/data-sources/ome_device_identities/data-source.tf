@@ -0,0 +1,36 @@
+# /*
+# Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#     http://mozilla.org/MPL/2.0/
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# */
+
+# Read the identities assigned to every device the template is deployed on
+data "ome_device_identities" "web" {
+  template_name = "web-tier R650"
+}
+
+# DHCP reservations for the first partition of every NIC port
+output "dhcp_reservations" {
+  value = {
+    for identity in data.ome_device_identities.web.identities :
+    "${identity.service_tag}-${identity.nic_id}" => identity.ethernet_mac
+    if identity.partition == 1 && identity.ethernet_mac != null
+  }
+}
+
+# Read the FC identities of specific devices for SAN zoning
+data "ome_device_identities" "db" {
+  template_name      = "db-tier R650"
+  device_servicetags = ["CZMC1T2", "CZNF1T2"]
+}
+
+output "san_zoning_wwpns" {
+  value = [for identity in data.ome_device_identities.db.identities : identity.fc_wwpn if identity.fc_wwpn != null]
+}
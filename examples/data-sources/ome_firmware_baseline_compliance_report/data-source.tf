@@ -21,6 +21,21 @@ data "ome_firmware_baseline_compliance_report" "report1" {
 			# key = "DeviceModel"
 			# value = "Valid Name"
 	# }
+
+  # Component rules of the baseline, excluded components are reported with the update action EXCLUDED
+  # and pinned components are compared against their pinned version
+  # component_rules = ome_firmware_baseline.firmware_baseline.component_rules
+  component_rules = [
+    {
+      action      = "Pin"
+      source_name = "DCIM:INSTALLED#701__NIC.*"
+      version     = "22.5.7"
+    },
+    {
+      action       = "Exclude"
+      component_id = "104298"
+    }
+  ]
 }
 
 output "all" {
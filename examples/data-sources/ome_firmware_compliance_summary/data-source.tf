@@ -0,0 +1,48 @@
+# /*
+# Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#     http://mozilla.org/MPL/2.0/
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# */
+
+
+# Firmware compliance of the R650 servers of a group, across two baselines
+data "ome_firmware_compliance_summary" "summary" {
+  baseline_names     = ["baseline_bios", "baseline_idrac"]
+  device_group_names = ["production"]
+  device_models      = ["PowerEdge R650"]
+
+  # the worst 5 devices and the 5 components out of date on the most devices
+  worst_devices_limit       = 5
+  outdated_components_limit = 5
+
+  # The component rules of the baselines can be applied before summarizing
+  # baseline_component_rules = [
+  #   {
+  #     baseline_name   = ome_firmware_baseline.firmware_baseline.name
+  #     component_rules = ome_firmware_baseline.firmware_baseline.component_rules
+  #   }
+  # ]
+}
+
+output "firmware_status_counts" {
+  value = data.ome_firmware_compliance_summary.summary.status_counts
+}
+
+output "worst_devices" {
+  value = [for device in data.ome_firmware_compliance_summary.summary.worst_devices : device.service_tag]
+}
+
+# Fail the run when a device is critically out of compliance
+check "firmware_compliance" {
+  assert {
+    condition     = data.ome_firmware_compliance_summary.summary.status_counts.critical == 0
+    error_message = "${data.ome_firmware_compliance_summary.summary.status_counts.critical} devices are critically out of firmware compliance."
+  }
+}
@@ -0,0 +1,37 @@
+# /*
+# Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#     http://mozilla.org/MPL/2.0/
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# */
+
+# Compare two templates
+data "ome_template_diff" "web_vs_db" {
+  source_template_name = "web-tier R650"
+  target_template_name = "db-tier R650"
+}
+
+output "changed_attributes" {
+  value = {
+    for attribute in data.ome_template_diff.web_vs_db.changed :
+    attribute.path => "${attribute.source_value} -> ${attribute.target_value}"
+  }
+}
+
+# Compare a template with the current BIOS and NIC configuration of a device
+# A temporary template is created from the device and deleted once compared
+data "ome_template_diff" "web_vs_device" {
+  source_template_name     = "web-tier R650"
+  target_device_servicetag = "CZMC1T2"
+  fqdds                    = "BIOS,NIC"
+}
+
+output "device_drifted" {
+  value = data.ome_template_diff.web_vs_device.has_differences
+}
@@ -0,0 +1,47 @@
+# /*
+# Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#     http://mozilla.org/MPL/2.0/
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# */
+
+# Export the content of a template, the passwords are redacted by default
+data "ome_template_export" "web" {
+  name = "web-tier R650"
+}
+
+# Version the exported content in git
+resource "local_file" "web" {
+  content  = data.ome_template_export.web.content
+  filename = "${path.module}/templates/web-tier.xml"
+}
+
+# Export only the BIOS and NIC components
+data "ome_template_export" "web_bios_nic" {
+  name  = "web-tier R650"
+  fqdds = ["BIOS", "NIC.Integrated.1"]
+}
+
+output "boot_mode" {
+  value = data.ome_template_export.web_bios_nic.attributes["BIOS.Setup.1-1/BootMode"]
+}
+
+# Promote the template to another appliance
+# The passwords are kept in the exported content when redact_passwords is false,
+# redacted content would import the redacted values as the passwords
+data "ome_template_export" "web_with_passwords" {
+  name             = "web-tier R650"
+  redact_passwords = false
+}
+
+resource "ome_template" "web_promoted" {
+  provider = ome.staging
+  name     = "web-tier R650"
+  content  = data.ome_template_export.web_with_passwords.content
+}
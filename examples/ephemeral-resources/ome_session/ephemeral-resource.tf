@@ -0,0 +1,36 @@
+# /*
+# Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#     http://mozilla.org/MPL/2.0/
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# */
+
+# Open an OME session, the token is never written to the state or the plan
+ephemeral "ome_session" "session" {
+}
+
+# Ephemeral values can be used to configure other providers,
+# for example a generic REST provider calling OME APIs which are not covered by this provider
+terraform {
+  required_providers {
+    restapi = {
+      source = "Mastercard/restapi"
+    }
+  }
+}
+
+provider "restapi" {
+  uri                  = ephemeral.ome_session.session.base_url
+  insecure             = true
+  write_returns_object = true
+  headers = {
+    Content-Type = "application/json"
+    X-Auth-Token = ephemeral.ome_session.session.token
+  }
+}
@@ -0,0 +1,30 @@
+# /*
+# Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#     http://mozilla.org/MPL/2.0/
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# */
+
+# Run the configuration compliance remediation every Sunday at 02:00
+resource "ome_configuration_compliance" "remediation" {
+  baseline_name = "baseline-r650"
+  target_devices = [
+    {
+      device_service_tag = "CZMC1T2"
+      compliance_status  = "Compliant"
+    }
+  ]
+  run_later = true
+  cron      = provider::ome::cron({ hour = "2", day_of_week = "SUN" })
+}
+
+output "every_15_minutes" {
+  # "0 */15 * * * ? *"
+  value = provider::ome::cron({ minute = "*/15" })
+}
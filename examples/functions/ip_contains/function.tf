@@ -0,0 +1,24 @@
+# /*
+# Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#     http://mozilla.org/MPL/2.0/
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# */
+
+# Keep only the devices of the lab subnets
+data "ome_device" "all" {
+}
+
+locals {
+  lab_networks = ["10.36.0.0/24", "10.37.0.1-10.37.0.50"]
+  lab_devices = [
+    for device in data.ome_device.all.devices : device
+    if provider::ome::ip_contains(local.lab_networks, device.device_management[0].network_address)
+  ]
+}
@@ -0,0 +1,22 @@
+# /*
+# Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#     http://mozilla.org/MPL/2.0/
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# */
+
+# Discover every iDRAC of a small range, one discovery target per address
+locals {
+  idrac_ips = provider::ome::ip_expand("10.36.0.10-10.36.0.14")
+}
+
+output "idrac_ips" {
+  # ["10.36.0.10", "10.36.0.11", "10.36.0.12", "10.36.0.13", "10.36.0.14"]
+  value = local.idrac_ips
+}
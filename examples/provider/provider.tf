@@ -29,6 +29,20 @@ provider "ome" {
   protocol = "https"
   skipssl  = false
 
+  ## Checks the firmware compliance of the devices targeted by ome_deployment and
+  ## ome_configuration_compliance while planning, devices CRITICAL or worse fail the plan
+  # firmware_compliance_guard = {
+  #   severity       = "CRITICAL"
+  #   policy         = "error"
+  #   baseline_names = ["firmware-baseline"]
+  #   baseline_component_rules = [
+  #     {
+  #       baseline_name   = "firmware-baseline"
+  #       component_rules = [{ action = "Exclude", source_name = "DCIM:INSTALLED#701__NIC.*" }]
+  #     }
+  #   ]
+  # }
+
   ## Can also be set using environment variables
   ## If environment variables are set it will override this configuration
   ## Example environment variables
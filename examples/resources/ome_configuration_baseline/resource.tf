@@ -50,3 +50,19 @@ resource "ome_configuration_baseline" "baseline3" {
   email_addresses = ["test@testmail.com"]
   output_format   = "pdf"
 }
+
+# Create Baseline using device groups, the subgroups of the given groups are targeted as well
+resource "ome_configuration_baseline" "baseline4" {
+  baseline_name   = "baseline4"
+  ref_template_id = 745
+  group_names     = ["Linux Servers", "Windows Servers"]
+  description     = "baseline description"
+}
+
+# Create Baseline using device group ids
+resource "ome_configuration_baseline" "baseline5" {
+  baseline_name   = "baseline5"
+  ref_template_id = 745
+  group_ids       = [1011, 1012]
+  description     = "baseline description"
+}
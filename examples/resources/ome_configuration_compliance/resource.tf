@@ -56,4 +56,21 @@ resource "ome_configuration_compliance" "remeditation" {
   depends_on = [
     ome_configuration_baseline.baseline
   ]
+}
+
+# Enforce the baseline: every apply remediates the target devices which drifted from the baseline,
+# at most 5 devices per apply and only between 1 AM and 5 AM UTC on weekends
+resource "ome_configuration_compliance" "enforce" {
+  baseline_name = "baseline_name"
+  target_devices = [
+    for service_tag in ["MX12345", "MX12346", "MX12347"] : {
+      device_service_tag = service_tag
+      compliance_status  = "Compliant"
+    }
+  ]
+  enforce = {
+    max_devices_per_apply = 5
+    excluded_service_tags = ["MX12347"]
+    maintenance_window    = "* * 1-4 ? * SAT,SUN *"
+  }
 }
\ No newline at end of file
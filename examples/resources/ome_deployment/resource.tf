@@ -101,4 +101,31 @@ resource "ome_deployment" "deploy-template-7" {
 resource "ome_deployment" "deploy-template-8" {
   template_id        = 614
   device_servicetags = concat(data.ome_groupdevices_info.gd.device_servicetags, ["MXL1235"])
-}
\ No newline at end of file
+}
+
+# Deploy template in batches of 10 devices, waiting 5 minutes between batches and stopping the rollout when more than 2 devices fail
+resource "ome_deployment" "deploy-template-9" {
+  template_name      = "deploy-template-9"
+  device_servicetags = data.ome_groupdevices_info.gd.device_servicetags
+  rollout = {
+    batch_size            = 10
+    pause_between_batches = 300
+    health_check          = true
+    max_failures          = 2
+  }
+}
+
+# Pre-provision profiles of the template to the empty compute slots of a MX7000 chassis, a replacement sled picks up the profile when it is inserted
+resource "ome_deployment" "deploy-template-10" {
+  template_name = "deploy-template-10"
+  slot_targets = [
+    {
+      chassis_servicetag = "MXCHAS1"
+      slot_number        = 1
+    },
+    {
+      chassis_servicetag = "MXCHAS1"
+      slot_number        = 2
+    },
+  ]
+}
@@ -38,4 +38,31 @@ resource "ome_firmware_baseline" "firmware_baseline" {
   
   # Description of the firmware baseline 
   description = "test baseline"
+
+  // Waits for the compliance of the baseline to be computed so that compliance_summary is final. This field is set to true by default.
+  #wait_for_completion = false
+
+  // Timeout in minutes for waiting for the compliance of the baseline. This field is set to 10 by default.
+  #timeout = 20
+
+  // Pins components to a version or excludes them from the baseline, the first rule matching a component applies.
+  // The rules are only kept in the terraform state and are not sent to OME. Pass them to the compliance data sources
+  // with component_rules = ome_firmware_baseline.firmware_baseline.component_rules for them to take effect.
+  # component_rules = [
+  #   {
+  #     action      = "Pin"
+  #     source_name = "DCIM:INSTALLED#701__NIC.*"
+  #     version     = "22.5.7"
+  #   },
+  #   {
+  #     action       = "Exclude"
+  #     component_id = "104298"
+  #   }
+  # ]
 }
+
+# The component rules of the baseline only take effect in the compliance reports they are passed to
+# data "ome_firmware_baseline_compliance_report" "report" {
+#   baseline_name   = ome_firmware_baseline.firmware_baseline.name
+#   component_rules = ome_firmware_baseline.firmware_baseline.component_rules
+# }
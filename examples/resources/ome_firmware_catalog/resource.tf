@@ -57,4 +57,16 @@ resource "ome_firmware_catalog" "firmware_catalog_example" {
 
   # Share password required value for the share (CIFS), optional value for the share (HTTPS)
   share_password = "example-pass"
+
+  # Test connection optional value, defaults to Apply.
+  # Tests that OME can read the catalog file from the share before the catalog is created or its share changes (Apply),
+  # also while planning (Plan), or never (Never)
+  test_connection = "Plan"
+
+  # Wait for completion optional value, defaults to true.
+  # Waits for the catalog to be downloaded so that status and last_update are final, set to false to not wait
+  wait_for_completion = true
+
+  # Timeout optional value, in minutes, for waiting for the catalog download. Defaults to 10
+  timeout = 20
 }
\ No newline at end of file
@@ -177,4 +177,14 @@ resource "ome_template" "template_8" {
   name      = "template_8"
   content   = file("../testdata/test_acc_template.xml")
   view_type = "Compliance"
+}
+
+# Create a template and set the values of its attributes by path, the values are applied on create and update.
+resource "ome_template" "template_9" {
+  name                 = "template_9"
+  refdevice_servicetag = "MXL1234"
+  attribute_values = {
+    "iDRAC,Time Zone Configuration Information,Time 1 Time Zone String" = "IST"
+    "System,Server Topology,ServerTopology 1 Aisle Name"                = "Aisle-123"
+  }
 }
\ No newline at end of file
@@ -0,0 +1,611 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"time"
+)
+
+const (
+	// KindTemplate - templates exported as ome_template
+	KindTemplate = "template"
+	// KindConfigurationBaseline - configuration baselines exported as ome_configuration_baseline
+	KindConfigurationBaseline = "configuration_baseline"
+	// KindFirmwareBaseline - firmware baselines exported as ome_firmware_baseline
+	KindFirmwareBaseline = "firmware_baseline"
+	// KindStaticGroup - static groups exported as ome_static_group
+	KindStaticGroup = "static_group"
+	// KindVlanNetwork - VLAN networks exported as ome_network_vlan
+	KindVlanNetwork = "network_vlan"
+	// KindUser - users exported as ome_user
+	KindUser = "user"
+	// KindFirmwareCatalog - firmware catalogs exported as ome_firmware_catalog
+	KindFirmwareCatalog = "firmware_catalog"
+	// KindDiscovery - discovery jobs exported as ome_discovery
+	KindDiscovery = "discovery"
+
+	// staticMembershipTypeID - membership type of the static groups
+	staticMembershipTypeID = 12
+	// builtinTemplateOwner - owner of the templates shipped with the appliance
+	builtinTemplateOwner = "system"
+	// builtinAdminUser - default administrator which must never be managed by terraform
+	builtinAdminUser = "admin"
+	// complianceViewTypeID - view type id of the compliance templates
+	complianceViewTypeID = 1
+	// chassisDeviceTypeID - device type id of the chassis templates
+	chassisDeviceTypeID = 4
+	// placeholder - value written for secrets which cannot be read back from OME
+	placeholder = "REPLACE_ME"
+)
+
+// SupportedKinds - kinds of objects the generator can export, in the order they are written
+var SupportedKinds = []string{
+	KindTemplate,
+	KindConfigurationBaseline,
+	KindFirmwareCatalog,
+	KindFirmwareBaseline,
+	KindStaticGroup,
+	KindVlanNetwork,
+	KindUser,
+	KindDiscovery,
+}
+
+// Generator lists objects from an OME appliance and renders import blocks along with the matching resource configuration.
+type Generator struct {
+	client *clients.Client
+	names  nameRegistry
+	// groupNames caches the group names by ID
+	groupNames map[int64]string
+}
+
+// New returns a generator backed by an OME client which already holds a session.
+func New(client *clients.Client) *Generator {
+	return &Generator{
+		client: client,
+		names:  nameRegistry{},
+	}
+}
+
+// ValidateKinds validates the requested kinds and returns all supported kinds when none are requested.
+func ValidateKinds(kinds []string) ([]string, error) {
+	valid := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		kind = strings.TrimSpace(kind)
+		if kind == "" {
+			continue
+		}
+		found := false
+		for _, supported := range SupportedKinds {
+			if kind == supported {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unsupported kind %q, supported kinds are %s", kind, strings.Join(SupportedKinds, ", "))
+		}
+		valid = append(valid, kind)
+	}
+	if len(valid) == 0 {
+		return SupportedKinds, nil
+	}
+	return valid, nil
+}
+
+// Generate writes the configuration of the requested kinds to w.
+func (g *Generator) Generate(w io.Writer, kinds []string) error {
+	kinds, err := ValidateKinds(kinds)
+	if err != nil {
+		return err
+	}
+	for _, kind := range kinds {
+		blocks, err := g.Blocks(kind)
+		if err != nil {
+			return fmt.Errorf("unable to generate %s configuration: %w", kind, err)
+		}
+		if len(blocks) == 0 {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "# ---- %s ----\n\n", kind); err != nil {
+			return err
+		}
+		for _, block := range sortedBlocks(blocks) {
+			if err := block.Render(w); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Blocks returns the blocks of a single kind.
+func (g *Generator) Blocks(kind string) ([]Block, error) {
+	switch kind {
+	case KindTemplate:
+		return g.templateBlocks()
+	case KindConfigurationBaseline:
+		return g.configurationBaselineBlocks()
+	case KindFirmwareBaseline:
+		return g.firmwareBaselineBlocks()
+	case KindStaticGroup:
+		return g.staticGroupBlocks()
+	case KindVlanNetwork:
+		return g.vlanNetworkBlocks()
+	case KindUser:
+		return g.userBlocks()
+	case KindFirmwareCatalog:
+		return g.firmwareCatalogBlocks()
+	case KindDiscovery:
+		return g.discoveryBlocks()
+	}
+	return nil, fmt.Errorf("unsupported kind %q", kind)
+}
+
+func (g *Generator) templateBlocks() ([]Block, error) {
+	templates, err := g.client.GetAllTemplates()
+	if err != nil {
+		return nil, err
+	}
+	blocks := []Block{}
+	for _, template := range templates {
+		if template.CreatedBy == builtinTemplateOwner {
+			continue
+		}
+		viewType := "Deployment"
+		if template.ViewTypeID == complianceViewTypeID {
+			viewType = "Compliance"
+		}
+		deviceType := "Server"
+		if template.TypeID == chassisDeviceTypeID {
+			deviceType = "Chassis"
+		}
+		attrs := []Attribute{
+			{Name: "name", Value: template.Name},
+			{Name: "view_type", Value: viewType},
+			{Name: "device_type", Value: deviceType},
+			{Name: "fqdds", Value: "All"},
+		}
+		if template.Description != "" {
+			attrs = append(attrs, Attribute{Name: "description", Value: template.Description})
+		}
+		if template.SourceDeviceID != 0 {
+			attrs = append(attrs, Attribute{Name: "refdevice_id", Value: template.SourceDeviceID})
+		}
+		blocks = append(blocks, Block{
+			ResourceType: "ome_template",
+			Name:         g.names.unique("ome_template", template.Name),
+			ImportID:     template.Name,
+			Attributes:   attrs,
+		})
+	}
+	return blocks, nil
+}
+
+func (g *Generator) configurationBaselineBlocks() ([]Block, error) {
+	baselines, err := g.client.GetAllBaselines()
+	if err != nil {
+		return nil, err
+	}
+	blocks := []Block{}
+	for _, baseline := range baselines {
+		deviceIDs := []int64{}
+		for _, target := range baseline.BaselineTargets {
+			deviceIDs = append(deviceIDs, target.ID)
+		}
+		sort.Slice(deviceIDs, func(i, j int) bool { return deviceIDs[i] < deviceIDs[j] })
+		attrs := []Attribute{
+			{Name: "baseline_name", Value: baseline.Name},
+			{Name: "ref_template_name", Value: baseline.TemplateName},
+		}
+		if baseline.Description != "" {
+			attrs = append(attrs, Attribute{Name: "description", Value: baseline.Description})
+		}
+		attrs = append(attrs, Attribute{Name: "device_ids", Value: deviceIDs})
+		blocks = append(blocks, Block{
+			ResourceType: "ome_configuration_baseline",
+			Name:         g.names.unique("ome_configuration_baseline", baseline.Name),
+			ImportID:     baseline.Name,
+			Attributes:   attrs,
+		})
+	}
+	return blocks, nil
+}
+
+func (g *Generator) firmwareBaselineBlocks() ([]Block, error) {
+	baselines, err := g.client.GetAllFirmwareBaselines()
+	if err != nil {
+		return nil, err
+	}
+	blocks := []Block{}
+	for _, baseline := range baselines {
+		if baseline.ID == nil {
+			continue
+		}
+		attrs := []Attribute{
+			{Name: "name", Value: baseline.Name},
+		}
+		if baseline.RepositoryName != nil {
+			attrs = append(attrs, Attribute{Name: "catalog_name", Value: *baseline.RepositoryName})
+		}
+		if baseline.Description != nil && *baseline.Description != "" {
+			attrs = append(attrs, Attribute{Name: "description", Value: *baseline.Description})
+		}
+		if baseline.FilterNoRebootRequired != nil {
+			attrs = append(attrs, Attribute{Name: "filter_no_reboot_required", Value: *baseline.FilterNoRebootRequired})
+		}
+		targetAttrs, err := g.firmwareBaselineTargets(baseline.Targets)
+		if err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, targetAttrs...)
+		blocks = append(blocks, Block{
+			ResourceType: "ome_firmware_baseline",
+			Name:         g.names.unique("ome_firmware_baseline", baseline.Name),
+			ImportID:     strconv.FormatInt(int64(*baseline.ID), 10),
+			Attributes:   attrs,
+		})
+	}
+	return blocks, nil
+}
+
+func (g *Generator) firmwareBaselineTargets(targets []models.TargetModel) ([]Attribute, error) {
+	deviceIDs := []int64{}
+	groupNames := []string{}
+	for _, target := range targets {
+		if target.Type.Name == "GROUP" {
+			name, err := g.groupName(target.ID)
+			if err != nil {
+				return nil, err
+			}
+			groupNames = append(groupNames, name)
+			continue
+		}
+		deviceIDs = append(deviceIDs, target.ID)
+	}
+	attrs := []Attribute{}
+	if len(deviceIDs) > 0 {
+		devices, err := g.client.GetDevices(nil, deviceIDs, nil)
+		if err != nil {
+			return nil, err
+		}
+		serviceTags := []string{}
+		for _, device := range devices {
+			serviceTags = append(serviceTags, device.DeviceServiceTag)
+		}
+		sort.Strings(serviceTags)
+		attrs = append(attrs, Attribute{Name: "device_service_tags", Value: serviceTags})
+	}
+	if len(groupNames) > 0 {
+		sort.Strings(groupNames)
+		attrs = append(attrs, Attribute{Name: "group_names", Value: groupNames})
+	}
+	return attrs, nil
+}
+
+func (g *Generator) groupName(id int64) (string, error) {
+	if g.groupNames == nil {
+		groups, err := g.client.GetAllGroups()
+		if err != nil {
+			return "", err
+		}
+		g.groupNames = map[int64]string{}
+		for _, group := range groups.Value {
+			g.groupNames[group.ID] = group.Name
+			for _, subGroup := range group.SubGroups {
+				g.groupNames[subGroup.ID] = subGroup.Name
+			}
+		}
+	}
+	name, ok := g.groupNames[id]
+	if !ok {
+		return "", fmt.Errorf("group with id %d could not be found", id)
+	}
+	return name, nil
+}
+
+func (g *Generator) staticGroupBlocks() ([]Block, error) {
+	groups, err := g.client.GetAllGroups()
+	if err != nil {
+		return nil, err
+	}
+	candidates := []models.Group{}
+	for _, group := range groups.Value {
+		candidates = append(candidates, group)
+		candidates = append(candidates, group.SubGroups...)
+	}
+	seen := map[int64]bool{}
+	blocks := []Block{}
+	for _, group := range candidates {
+		// only user created static groups, the root containers have no parent
+		if group.MembershipTypeID != staticMembershipTypeID || group.ParentID == 0 || seen[group.ID] {
+			continue
+		}
+		seen[group.ID] = true
+		devices, err := g.client.GetDevicesByGroupID(group.ID)
+		if err != nil {
+			return nil, err
+		}
+		deviceIDs := []int64{}
+		for _, device := range devices.Value {
+			deviceIDs = append(deviceIDs, device.ID)
+		}
+		sort.Slice(deviceIDs, func(i, j int) bool { return deviceIDs[i] < deviceIDs[j] })
+		attrs := []Attribute{
+			{Name: "name", Value: group.Name},
+			{Name: "parent_id", Value: group.ParentID},
+		}
+		if group.Description != "" {
+			attrs = append(attrs, Attribute{Name: "description", Value: group.Description})
+		}
+		attrs = append(attrs, Attribute{Name: "device_ids", Value: deviceIDs})
+		blocks = append(blocks, Block{
+			ResourceType: "ome_static_group",
+			Name:         g.names.unique("ome_static_group", group.Name),
+			ImportID:     group.Name,
+			Attributes:   attrs,
+		})
+	}
+	return blocks, nil
+}
+
+func (g *Generator) vlanNetworkBlocks() ([]Block, error) {
+	vlans, err := g.client.GetAllVlanNetworks()
+	if err != nil {
+		return nil, err
+	}
+	blocks := []Block{}
+	for _, vlan := range vlans {
+		attrs := []Attribute{
+			{Name: "name", Value: vlan.Name},
+		}
+		if vlan.Description != "" {
+			attrs = append(attrs, Attribute{Name: "description", Value: vlan.Description})
+		}
+		attrs = append(attrs,
+			Attribute{Name: "vlan_minimum", Value: vlan.VLANMinimum},
+			Attribute{Name: "vlan_maximum", Value: vlan.VLANMaximum},
+			Attribute{Name: "type", Value: int64(vlan.Type)},
+		)
+		blocks = append(blocks, Block{
+			ResourceType: "ome_network_vlan",
+			Name:         g.names.unique("ome_network_vlan", vlan.Name),
+			ImportID:     strconv.FormatInt(vlan.ID, 10),
+			Attributes:   attrs,
+		})
+	}
+	return blocks, nil
+}
+
+func (g *Generator) userBlocks() ([]Block, error) {
+	users, err := g.client.GetAllUsers()
+	if err != nil {
+		return nil, err
+	}
+	blocks := []Block{}
+	for _, user := range users {
+		if user.UserName == builtinAdminUser {
+			continue
+		}
+		attrs := []Attribute{
+			{Name: "username", Value: user.UserName},
+			{Name: "password", Value: placeholder, Comment: "passwords cannot be read from OME, set the password of the user"},
+			{Name: "role_id", Value: user.RoleID},
+			{Name: "enabled", Value: user.Enabled},
+			{Name: "locked", Value: user.Locked},
+		}
+		if user.Description != "" {
+			attrs = append(attrs, Attribute{Name: "description", Value: user.Description})
+		}
+		blocks = append(blocks, Block{
+			ResourceType: "ome_user",
+			Name:         g.names.unique("ome_user", user.UserName),
+			// the user import ID is of the form <id>,<password>
+			ImportID:   user.ID + "," + placeholder,
+			Comments:   []string{fmt.Sprintf("replace %s in the import ID and the password with the password of the user", placeholder)},
+			Attributes: attrs,
+		})
+	}
+	return blocks, nil
+}
+
+func (g *Generator) firmwareCatalogBlocks() ([]Block, error) {
+	catalogs, err := g.client.GetAllCatalogFirmware()
+	if err != nil {
+		return nil, err
+	}
+	blocks := []Block{}
+	for _, catalog := range catalogs.Value {
+		repo := catalog.Repository
+		attrs := []Attribute{
+			{Name: "name", Value: repo.Name},
+			{Name: "share_type", Value: repo.RepositoryType},
+		}
+		comments := []string{}
+		if repo.RepositoryType != "DELL_ONLINE" {
+			attrs = append(attrs,
+				Attribute{Name: "share_address", Value: repo.Source},
+				Attribute{Name: "catalog_file_path", Value: strings.TrimPrefix(catalog.SourcePath+"/"+catalog.Filename, "/")},
+			)
+			if repo.DomainName != "" {
+				attrs = append(attrs, Attribute{Name: "domain", Value: repo.DomainName})
+			}
+			if repo.Username != "" {
+				attrs = append(attrs,
+					Attribute{Name: "share_user", Value: repo.Username},
+					Attribute{Name: "share_password", Value: placeholder, Comment: "share passwords cannot be read from OME"},
+				)
+			}
+		}
+		if catalog.Schedule.Cron != "" && catalog.Schedule.Cron != clients.RunNowSchedule {
+			comments = append(comments, fmt.Sprintf("the catalog is refreshed with the schedule %q, set catalog_update_type and catalog_refresh_schedule to keep it", catalog.Schedule.Cron))
+		}
+		blocks = append(blocks, Block{
+			ResourceType: "ome_firmware_catalog",
+			Name:         g.names.unique("ome_firmware_catalog", repo.Name),
+			ImportID:     strconv.FormatInt(catalog.ID, 10),
+			Comments:     comments,
+			Attributes:   attrs,
+		})
+	}
+	return blocks, nil
+}
+
+func (g *Generator) discoveryBlocks() ([]Block, error) {
+	jobs, err := g.client.GetAllDiscoveryJobs()
+	if err != nil {
+		return nil, err
+	}
+	deviceTypes := map[int]string{
+		1000: "SERVER",
+		7000: "NETWORK SWITCH",
+		2000: "CHASSIS",
+		5000: "STORAGE",
+	}
+	blocks := []Block{}
+	for _, job := range jobs {
+		targets := []Object{}
+		for _, model := range job.DiscoveryConfigModels {
+			addresses := []string{}
+			for _, target := range model.DiscoveryConfigTargets {
+				addresses = append(addresses, target.NetworkAddressDetail)
+			}
+			types := []string{}
+			for _, id := range model.DeviceType {
+				if name, ok := deviceTypes[id]; ok {
+					types = append(types, name)
+				}
+			}
+			target := Object{
+				{Name: "network_address_detail", Value: addresses},
+				{Name: "device_type", Value: types},
+			}
+			target = append(target, discoveryCredentials(model.ConnectionProfile)...)
+			targets = append(targets, target)
+		}
+		attrs := []Attribute{
+			{Name: "name", Value: job.DiscoveryConfigGroupName},
+		}
+		if job.Schedule.RunLater || (job.Schedule.Cron != "" && job.Schedule.Cron != clients.RunNowSchedule) {
+			attrs = append(attrs,
+				Attribute{Name: "schedule", Value: "RunLater"},
+				Attribute{Name: "cron", Value: job.Schedule.Cron},
+			)
+		} else {
+			attrs = append(attrs,
+				Attribute{Name: "schedule", Value: "RunNow"},
+				Attribute{Name: "timeout", Value: int64(10)},
+				Attribute{Name: "ignore_partial_failure", Value: true},
+			)
+		}
+		if job.DiscoveryStatusEmailRecipient != "" {
+			attrs = append(attrs, Attribute{Name: "email_recipient", Value: job.DiscoveryStatusEmailRecipient})
+		}
+		attrs = append(attrs,
+			Attribute{Name: "trap_destination", Value: job.TrapDestination},
+			Attribute{Name: "enable_community_strings", Value: job.CommunityString},
+			Attribute{Name: "discovery_config_targets", Value: targets},
+		)
+		blocks = append(blocks, Block{
+			ResourceType: "ome_discovery",
+			Name:         g.names.unique("ome_discovery", job.DiscoveryConfigGroupName),
+			ImportID:     strconv.Itoa(job.DiscoveryConfigGroupID),
+			Comments:     []string{fmt.Sprintf("credentials cannot be read from OME, replace every %s with the protocol credentials", placeholder)},
+			Attributes:   attrs,
+		})
+	}
+	return blocks, nil
+}
+
+// discoveryCredentials returns the protocol attributes found in the connection profile of a discovery target.
+func discoveryCredentials(connectionProfile string) []Attribute {
+	profiles := models.ConnectionProfiles{}
+	if err := json.Unmarshal([]byte(connectionProfile), &profiles); err != nil {
+		return nil
+	}
+	attrs := []Attribute{}
+	for _, creds := range profiles.Credentials {
+		username := ""
+		if credMap, ok := creds.Credential.(map[string]interface{}); ok {
+			username, _ = credMap["username"].(string)
+		}
+		switch creds.Type {
+		case "REDFISH", "WSMAN", "SSH":
+			attrs = append(attrs, Attribute{Name: strings.ToLower(creds.Type), Value: Object{
+				{Name: "username", Value: username},
+				{Name: "password", Value: placeholder},
+			}})
+		case "SNMP":
+			attrs = append(attrs, Attribute{Name: "snmp", Value: Object{
+				{Name: "community", Value: placeholder},
+			}})
+		}
+	}
+	return attrs
+}
+
+// ClientOptionsFromEnv builds the client options from the same environment variables the provider reads.
+func ClientOptionsFromEnv() (clients.ClientOptions, error) {
+	host := os.Getenv("OME_HOST")
+	username := os.Getenv("OME_USERNAME")
+	password := os.Getenv("OME_PASSWORD")
+	if host == "" || username == "" || password == "" {
+		return clients.ClientOptions{}, fmt.Errorf("OME_HOST, OME_USERNAME and OME_PASSWORD must be set")
+	}
+	port := int64(443)
+	if v := os.Getenv("OME_PORT"); v != "" {
+		p, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return clients.ClientOptions{}, fmt.Errorf("invalid OME_PORT %q: %w", v, err)
+		}
+		port = p
+	}
+	timeout := 30 * time.Second
+	if v := os.Getenv("OME_TIMEOUT"); v != "" {
+		t, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return clients.ClientOptions{}, fmt.Errorf("invalid OME_TIMEOUT %q: %w", v, err)
+		}
+		timeout = time.Second * time.Duration(t)
+	}
+	skipSSL := false
+	if v := os.Getenv("OME_SKIP_SSL"); v != "" {
+		s, err := strconv.ParseBool(v)
+		if err != nil {
+			return clients.ClientOptions{}, fmt.Errorf("invalid OME_SKIP_SSL %q: %w", v, err)
+		}
+		skipSSL = s
+	}
+	protocol := "https"
+	if v := os.Getenv("OME_PROTOCOL"); v != "" {
+		protocol = v
+	}
+	return clients.ClientOptions{
+		Username:       username,
+		Password:       password,
+		URL:            clients.GetURL(protocol, host, port),
+		SkipSSL:        skipSSL,
+		Timeout:        timeout,
+		Retry:          clients.Retries,
+		PreRequestHook: clients.ClientPreReqHook,
+	}, nil
+}
@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	_ "embed"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-ome/clients"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	//go:embed json_data/responseGetAllTemplates.json
+	responseGetAllTemplates []byte
+	//go:embed json_data/responseGetAllUsers.json
+	responseGetAllUsers []byte
+	//go:embed json_data/responseGetAllVlanNetworks.json
+	responseGetAllVlanNetworks []byte
+	//go:embed json_data/responseGetAllGroups.json
+	responseGetAllGroups []byte
+	//go:embed json_data/responseGetGroupDevices.json
+	responseGetGroupDevices []byte
+)
+
+func createGeneratorServer(t *testing.T) *httptest.Server {
+	responses := map[string][]byte{
+		clients.TemplateAPI:                       responseGetAllTemplates,
+		clients.UserAPI:                           responseGetAllUsers,
+		clients.VlanNetworksAPI:                   responseGetAllVlanNetworks,
+		clients.GroupAPI:                          responseGetAllGroups,
+		"/api/GroupService/Groups(10321)/Devices": responseGetGroupDevices,
+	}
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		if body, ok := responses[r.URL.Path]; ok {
+			_, _ = w.Write(body)
+			return
+		}
+		t.Logf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	return ts
+}
+
+func newTestGenerator(t *testing.T, ts *httptest.Server) *Generator {
+	c, err := clients.NewClient(clients.ClientOptions{
+		URL:      ts.URL,
+		SkipSSL:  true,
+		Timeout:  time.Second * 30,
+		Retry:    1,
+		Username: "admin",
+		Password: "Password123!",
+	})
+	assert.Nil(t, err)
+	return New(c)
+}
+
+func TestSanitizeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Spaces", "web-tier R650", "web_tier_r650"},
+		{"LeadingDigit", "42 rack", "_42_rack"},
+		{"Trimmed", "  --Storage--  ", "storage"},
+		{"Empty", "***", "unnamed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, SanitizeName(tt.input))
+		})
+	}
+}
+
+func TestUniqueNames(t *testing.T) {
+	names := nameRegistry{}
+	assert.Equal(t, "storage", names.unique("ome_network_vlan", "storage"))
+	assert.Equal(t, "storage_2", names.unique("ome_network_vlan", "Storage"))
+	assert.Equal(t, "storage", names.unique("ome_static_group", "storage"))
+}
+
+func TestValidateKinds(t *testing.T) {
+	kinds, err := ValidateKinds(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, SupportedKinds, kinds)
+
+	kinds, err = ValidateKinds([]string{""})
+	assert.Nil(t, err)
+	assert.Equal(t, SupportedKinds, kinds)
+
+	kinds, err = ValidateKinds([]string{"template", " user "})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"template", "user"}, kinds)
+
+	_, err = ValidateKinds([]string{"template", "invalid"})
+	assert.NotNil(t, err)
+}
+
+func TestGenerate(t *testing.T) {
+	ts := createGeneratorServer(t)
+	defer ts.Close()
+
+	var sb strings.Builder
+	err := newTestGenerator(t, ts).Generate(&sb, []string{KindTemplate, KindStaticGroup, KindVlanNetwork, KindUser})
+	assert.Nil(t, err)
+	out := sb.String()
+
+	// built-in objects are skipped
+	assert.NotContains(t, out, "iDRAC Enable Remote Syslog")
+	assert.NotContains(t, out, `"admin"`)
+	assert.NotContains(t, out, `"dynamic"`)
+
+	assert.Contains(t, out, "import {\n  to = ome_template.web_tier_r650\n  id = \"web-tier R650\"\n}")
+	assert.Contains(t, out, `description  = "Template for the $${web} tier"`)
+	assert.Contains(t, out, "refdevice_id = 10112")
+
+	assert.Contains(t, out, "to = ome_static_group.rack_42")
+	assert.Contains(t, out, "device_ids  = [10104, 10112]")
+
+	assert.Contains(t, out, "to = ome_network_vlan.storage\n  id = \"10133\"")
+	assert.Contains(t, out, "to = ome_network_vlan.storage_2\n  id = \"10134\"")
+
+	assert.Contains(t, out, "to = ome_user.ops_viewer\n  id = \"10434,REPLACE_ME\"")
+	assert.Contains(t, out, `role_id  = "16"`)
+}
+
+func TestGenerateError(t *testing.T) {
+	ts := createGeneratorServer(t)
+	defer ts.Close()
+
+	var sb strings.Builder
+	err := newTestGenerator(t, ts).Generate(&sb, []string{KindDiscovery})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Attribute is a single argument of a generated resource block.
+// Value may be a string, bool, int, int64, []string, []int64, []Object or Object.
+type Attribute struct {
+	Name    string
+	Value   interface{}
+	Comment string
+}
+
+// Object is an ordered list of attributes rendered as an HCL object value.
+type Object []Attribute
+
+// Block holds everything needed to render an import block and the matching resource block.
+type Block struct {
+	// ResourceType - type of the terraform resource, for example ome_template
+	ResourceType string
+	// Name - sanitised terraform resource name
+	Name string
+	// ImportID - the ID accepted by the ImportState method of the resource
+	ImportID string
+	// Comments - notes rendered above the resource block
+	Comments   []string
+	Attributes []Attribute
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// SanitizeName converts an OME object name to a valid terraform resource name.
+func SanitizeName(name string) string {
+	sanitized := invalidNameChars.ReplaceAllString(strings.ToLower(strings.TrimSpace(name)), "_")
+	sanitized = strings.Trim(sanitized, "_")
+	if sanitized == "" {
+		return "unnamed"
+	}
+	if sanitized[0] >= '0' && sanitized[0] <= '9' {
+		sanitized = "_" + sanitized
+	}
+	return sanitized
+}
+
+// nameRegistry hands out unique resource names per resource type.
+type nameRegistry map[string]map[string]bool
+
+func (n nameRegistry) unique(resourceType, name string) string {
+	if _, ok := n[resourceType]; !ok {
+		n[resourceType] = map[string]bool{}
+	}
+	base := SanitizeName(name)
+	candidate := base
+	for i := 2; n[resourceType][candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", base, i)
+	}
+	n[resourceType][candidate] = true
+	return candidate
+}
+
+// quote renders a string as an HCL string literal, escaping template sequences.
+func quote(in string) string {
+	quoted := strconv.Quote(in)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
+
+func renderValue(value interface{}, indent string) string {
+	switch v := value.(type) {
+	case string:
+		return quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case []string:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, quote(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case []int64:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, strconv.FormatInt(item, 10))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case Object:
+		return renderObject(v, indent)
+	case []Object:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, indent+"  "+renderObject(item, indent+"  "))
+		}
+		return "[\n" + strings.Join(items, ",\n") + "\n" + indent + "]"
+	}
+	return quote(fmt.Sprint(value))
+}
+
+func renderObject(obj Object, indent string) string {
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	writeAttributes(&sb, obj, indent+"  ")
+	sb.WriteString(indent + "}")
+	return sb.String()
+}
+
+func writeAttributes(sb *strings.Builder, attrs []Attribute, indent string) {
+	width := 0
+	for _, attr := range attrs {
+		if len(attr.Name) > width {
+			width = len(attr.Name)
+		}
+	}
+	for _, attr := range attrs {
+		if attr.Comment != "" {
+			sb.WriteString(indent + "# " + attr.Comment + "\n")
+		}
+		sb.WriteString(fmt.Sprintf("%s%-*s = %s\n", indent, width, attr.Name, renderValue(attr.Value, indent)))
+	}
+}
+
+// Render writes the import block followed by the resource block.
+func (b Block) Render(w io.Writer) error {
+	var sb strings.Builder
+	sb.WriteString("import {\n")
+	sb.WriteString(fmt.Sprintf("  to = %s.%s\n", b.ResourceType, b.Name))
+	sb.WriteString(fmt.Sprintf("  id = %s\n", quote(b.ImportID)))
+	sb.WriteString("}\n\n")
+	for _, comment := range b.Comments {
+		sb.WriteString("# " + comment + "\n")
+	}
+	sb.WriteString(fmt.Sprintf("resource %q %q {\n", b.ResourceType, b.Name))
+	writeAttributes(&sb, b.Attributes, "  ")
+	sb.WriteString("}\n\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func sortedBlocks(blocks []Block) []Block {
+	sort.SliceStable(blocks, func(i, j int) bool {
+		return blocks[i].Name < blocks[j].Name
+	})
+	return blocks
+}
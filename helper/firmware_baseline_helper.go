@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // CreateTargetModel create the target model based on the input plan
@@ -128,10 +129,51 @@ func SetStateBaseline(ctx context.Context, baseline models.FirmwareBaselinesMode
 	// Set the user input values to the state
 	state.Name = plan.Name
 	state.CatalogName = plan.CatalogName
+	state.WaitForCompletion = plan.WaitForCompletion
+	if state.WaitForCompletion.IsNull() || state.WaitForCompletion.IsUnknown() {
+		state.WaitForCompletion = types.BoolValue(true)
+	}
+	state.Timeout = plan.Timeout
+	state.ComponentRules = plan.ComponentRules
+	if state.ComponentRules.IsNull() {
+		state.ComponentRules = types.ListNull(types.ObjectType{AttrTypes: FirmwareComponentRuleTypes})
+	}
 
 	return state, nil
 }
 
+// FirmwareComponentRuleTypes - attribute types of a firmware component rule
+var FirmwareComponentRuleTypes = map[string]attr.Type{
+	"action":       types.StringType,
+	"source_name":  types.StringType,
+	"component_id": types.StringType,
+	"version":      types.StringType,
+}
+
+// GetFirmwareComponentRules reads and validates the component rules of the baseline
+func GetFirmwareComponentRules(ctx context.Context, plan models.FirmwareBaselineResource) ([]models.FirmwareComponentRule, error) {
+	ruleModels := []models.FirmwareComponentRuleModel{}
+	if diags := plan.ComponentRules.ElementsAs(ctx, &ruleModels, true); diags.HasError() {
+		return nil, fmt.Errorf("failed to read component rules")
+	}
+	rules := NewFirmwareComponentRules(ruleModels)
+	return rules, clients.ValidateFirmwareComponentRules(rules)
+}
+
+// NewFirmwareComponentRules converts the tfsdk component rules to the rules applied to the compliance reports
+func NewFirmwareComponentRules(ruleModels []models.FirmwareComponentRuleModel) []models.FirmwareComponentRule {
+	rules := make([]models.FirmwareComponentRule, 0, len(ruleModels))
+	for _, rule := range ruleModels {
+		rules = append(rules, models.FirmwareComponentRule{
+			Action:      rule.Action.ValueString(),
+			SourceName:  rule.SourceName.ValueString(),
+			ComponentID: rule.ComponentID.ValueString(),
+			Version:     rule.Version.ValueString(),
+		})
+	}
+	return rules
+}
+
 // MapBaselineComplianceSummary maps the compliance summary model to a types.Object.
 func MapBaselineComplianceSummary(complianceSummary models.ComplianceSummaryModel) (types.Object, diag.Diagnostics) {
 
@@ -207,6 +249,37 @@ func GetFirmwareBaselineWithID(client clients.Client, id int64) (models.Firmware
 	return client.GetFirmwareBaselineWithID(id)
 }
 
+// WaitForBaselineComplianceTask waits for the job returned when the baseline was created or updated, which is the compliance task of the baseline,
+// and for the compliance task the baseline reports when it is another one. It returns the baseline as last read from OME.
+func WaitForBaselineComplianceTask(ctx context.Context, client *clients.Client, baseline models.FirmwareBaselinesModel, jobID, maxRetries, sleepInterval int64) (models.FirmwareBaselinesModel, error) {
+	taskIDs := []int64{}
+	if jobID > 0 {
+		taskIDs = append(taskIDs, jobID)
+	}
+	if baseline.TaskID != nil && *baseline.TaskID > 0 && *baseline.TaskID != jobID {
+		taskIDs = append(taskIDs, *baseline.TaskID)
+	}
+	var taskErr error
+	for _, taskID := range taskIDs {
+		tflog.Debug(ctx, "waiting for the compliance task of the baseline", map[string]interface{}{
+			"baseline": baseline.Name,
+			"taskid":   taskID,
+		})
+		if taskErr = client.TrackJobRun(ctx, taskID, maxRetries, sleepInterval, clients.JobTracking{}); taskErr != nil {
+			break
+		}
+	}
+	if baseline.ID != nil {
+		if final, err := client.GetFirmwareBaselineWithID(int64(*baseline.ID)); err == nil {
+			baseline = final
+		}
+	}
+	if taskErr != nil {
+		return baseline, fmt.Errorf("compliance task of baseline %s could not complete: %w", baseline.Name, taskErr)
+	}
+	return baseline, nil
+}
+
 // CreateFirmwareBaseline - Creates a new Firmware baseline
 func CreateFirmwareBaseline(client *clients.Client, payload models.CreateUpdateFirmwareBaseline) (int64, error) {
 	return client.CreateFirmwareBaseline(payload)
@@ -24,6 +24,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// TestConnectionApply tests the connection to the catalog share at apply time
+	TestConnectionApply = "Apply"
+	// TestConnectionPlan tests the connection to the catalog share at plan and apply time
+	TestConnectionPlan = "Plan"
+	// TestConnectionNever skips the test of the connection to the catalog share
+	TestConnectionNever = "Never"
 )
 
 // GetAllCatalogFirmware get all catalog firmware
@@ -83,10 +93,50 @@ func SetStateCatalogFirmware(ctx context.Context, cat models.CatalogsModel, plan
 	state.SharePassword = plan.SharePassword
 	state.Domain = plan.Domain
 	state.ShareAddress = plan.ShareAddress
+	state.TestConnection = plan.TestConnection
+	if state.TestConnection.IsNull() || state.TestConnection.IsUnknown() {
+		state.TestConnection = types.StringValue(TestConnectionApply)
+	}
+	state.WaitForCompletion = plan.WaitForCompletion
+	if state.WaitForCompletion.IsNull() || state.WaitForCompletion.IsUnknown() {
+		state.WaitForCompletion = types.BoolValue(true)
+	}
+	state.Timeout = plan.Timeout
 
 	return state, nil
 }
 
+// WaitForCatalogTask waits for the task downloading the catalog to finish and returns the catalog as last read from OME.
+// When lastRun is set, the run of the task started at lastRun is the download preceding an update and a newer run is waited for.
+func WaitForCatalogTask(ctx context.Context, client *clients.Client, cat models.CatalogsModel, name string, lastRun *string, maxRetries, sleepInterval int64) (models.CatalogsModel, error) {
+	id := cat.ID
+	if id == 0 {
+		var idErr error
+		if id, idErr = GetIDFromNameFirmwareCatalog(client, name); idErr != nil {
+			return cat, idErr
+		}
+	}
+	current, err := GetSpecificCatalogFirmware(client, id)
+	if err != nil {
+		return cat, err
+	}
+	if current.TaskID == 0 {
+		return current, nil
+	}
+	tflog.Debug(ctx, "waiting for the download task of the catalog", map[string]interface{}{
+		"catalog": name,
+		"taskid":  current.TaskID,
+	})
+	taskErr := client.TrackJobRun(ctx, current.TaskID, maxRetries, sleepInterval, clients.JobTracking{LastRun: lastRun})
+	if final, err := GetSpecificCatalogFirmware(client, id); err == nil {
+		current = final
+	}
+	if taskErr != nil {
+		return current, fmt.Errorf("download task of catalog %s could not complete: %w", name, taskErr)
+	}
+	return current, nil
+}
+
 // GetIDFromNameFirmwareCatalog - Get the ID after create, for whatever reason the create api does not return the actual ID Instead it returns 0. The only way to get the true id is to get all of the catalogs and find the one that matches by name (names are required to be unique for catalogs)
 func GetIDFromNameFirmwareCatalog(client *clients.Client, name string) (int64, error) {
 	allCats, allErr := GetAllCatalogFirmware(client)
@@ -98,7 +148,7 @@ func GetIDFromNameFirmwareCatalog(client *clients.Client, name string) (int64, e
 			return cm.ID, nil
 		}
 	}
-	return 0, fmt.Errorf("unable to get the Id of the catalog for catalog: %s. catalog was unable to be found", name)
+	return 0, fmt.Errorf("unable to get the Id of the catalog for catalog: %s. %w", name, clients.ErrItemNotFound)
 }
 
 // MapAssociatedBaselines map the associated baselines to the terraform list attribute
@@ -263,15 +313,20 @@ func FilterCatalogFirmware(ctx context.Context, filterElements []string, cat *mo
 }
 
 // ValidateCatalogUpdate validates catalog update for the different share_type cases
-func ValidateCatalogUpdate(plan models.OmeSingleCatalogResource, state models.OmeSingleCatalogResource) error {
+func ValidateCatalogUpdate(client *clients.Client, plan models.OmeSingleCatalogResource, state models.OmeSingleCatalogResource) error {
 	if plan.ShareType != state.ShareType {
 		return fmt.Errorf("catalog share type is not allowed to be updated after create")
 	}
-	return nil
+	// the share is only tested again when the way it is reached changes
+	if plan.ShareAddress.Equal(state.ShareAddress) && plan.CatalogFilePath.Equal(state.CatalogFilePath) &&
+		plan.ShareUser.Equal(state.ShareUser) && plan.SharePassword.Equal(state.SharePassword) && plan.Domain.Equal(state.Domain) {
+		return nil
+	}
+	return TestCatalogConnection(client, plan)
 }
 
 // ValidateCatalogCreate validates catalog create for the different share_type cases
-func ValidateCatalogCreate(plan models.OmeSingleCatalogResource) error {
+func ValidateCatalogCreate(client *clients.Client, plan models.OmeSingleCatalogResource) error {
 
 	// Validate Automatic Update type
 	if plan.CatalogUpdateType.ValueString() == "Automatic" {
@@ -283,6 +338,15 @@ func ValidateCatalogCreate(plan models.OmeSingleCatalogResource) error {
 		}
 	}
 
+	err := validateCatalogShare(plan)
+	if err != nil {
+		return err
+	}
+	return TestCatalogConnection(client, plan)
+}
+
+// validateCatalogShare validates the share values required by the share_type
+func validateCatalogShare(plan models.OmeSingleCatalogResource) error {
 	// Validate Share Type Values
 	switch plan.ShareType.ValueString() {
 	case "NFS":
@@ -311,6 +375,18 @@ func ValidateCatalogCreate(plan models.OmeSingleCatalogResource) error {
 	return nil
 }
 
+// TestCatalogConnection runs the repository test connection of OME for the share of the catalog, unless test_connection is Never
+func TestCatalogConnection(client *clients.Client, plan models.OmeSingleCatalogResource) error {
+	if plan.TestConnection.ValueString() == TestConnectionNever || plan.ShareType.ValueString() == "DELL_ONLINE" {
+		return nil
+	}
+	err := validateCatalogShare(plan)
+	if err != nil {
+		return err
+	}
+	return client.CheckRepositoryConnection(MakeCatalogJSONModel(0, 0, plan))
+}
+
 // GetCatalogFirmwareByName filter catalog firmware
 func GetCatalogFirmwareByName(client *clients.Client, name string) (*models.CatalogsModel, error) {
 	// Get all catalog firmware
@@ -0,0 +1,108 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetFirmwareComplianceSummaryReports gets the firmware compliance reports of the baselines, keyed by baseline name,
+// with the component rules of each baseline applied, baseline names of the rules are case insensitive.
+func GetFirmwareComplianceSummaryReports(ctx context.Context, client *clients.Client, baselineNames []string, rules map[string][]models.FirmwareComponentRule) (map[string][]models.DeviceComplianceReport, error) {
+	reports := map[string][]models.DeviceComplianceReport{}
+	for _, name := range baselineNames {
+		baselineID, err := client.GetUpdateServiceBaselineIDByName(name)
+		if err != nil {
+			return nil, err
+		}
+		report, err := GetFwBaselineComplianceReport(ctx, client, baselineID, "", "")
+		if err != nil {
+			return nil, fmt.Errorf("could not get the compliance report of baseline %s: %w", name, err)
+		}
+		for baselineName, baselineRules := range rules {
+			if strings.EqualFold(baselineName, name) {
+				clients.ApplyFirmwareComponentRules(report.Value, baselineRules)
+			}
+		}
+		reports[name] = report.Value
+	}
+	return reports, nil
+}
+
+// GetFirmwareComplianceGroupDeviceIDs gets the ids of the devices of the groups, nil when there are no groups.
+func GetFirmwareComplianceGroupDeviceIDs(client *clients.Client, groupNames []string) (map[int64]bool, error) {
+	if len(groupNames) == 0 {
+		return nil, nil
+	}
+	devices, err := client.GetDevices(nil, nil, groupNames)
+	if err != nil {
+		return nil, err
+	}
+	deviceIDs := map[int64]bool{}
+	for _, device := range devices {
+		deviceIDs[device.ID] = true
+	}
+	return deviceIDs, nil
+}
+
+// SetStateFirmwareComplianceSummary sets the firmware compliance summary in the data source state
+func SetStateFirmwareComplianceSummary(ctx context.Context, summary models.FirmwareComplianceSummary, state *models.FirmwareComplianceSummaryData) error {
+	state.TotalDevices = types.Int64Value(summary.TotalDevices)
+	state.StatusCounts = models.FirmwareStatusCountsData{
+		Ok:        types.Int64Value(summary.StatusCounts[string(models.OK)]),
+		Warning:   types.Int64Value(summary.StatusCounts[string(models.WARNING)]),
+		Critical:  types.Int64Value(summary.StatusCounts[string(models.CRITICAL)]),
+		Downgrade: types.Int64Value(summary.StatusCounts[string(models.DOWNGRADE)]),
+		Unknown:   types.Int64Value(summary.StatusCounts[string(models.UNKNOWN)]),
+	}
+	state.RebootRequiredDevices = types.Int64Value(summary.RebootRequiredDevices)
+	state.RebootRequiredPercentage = types.Float64Value(summary.RebootRequiredPercentage)
+
+	state.WorstDevices = make([]models.FirmwareComplianceDeviceData, 0, len(summary.WorstDevices))
+	for _, device := range summary.WorstDevices {
+		baselineNames, diags := types.ListValueFrom(ctx, types.StringType, device.BaselineNames)
+		if diags.HasError() {
+			return fmt.Errorf("could not set the baselines of device %s", device.ServiceTag)
+		}
+		state.WorstDevices = append(state.WorstDevices, models.FirmwareComplianceDeviceData{
+			DeviceID:               types.Int64Value(device.DeviceID),
+			DeviceName:             types.StringValue(device.DeviceName),
+			ServiceTag:             types.StringValue(device.ServiceTag),
+			DeviceModel:            types.StringValue(device.DeviceModel),
+			ComplianceStatus:       types.StringValue(device.ComplianceStatus),
+			BaselineNames:          baselineNames,
+			NonCompliantComponents: types.Int64Value(device.NonCompliantComponents),
+			CriticalComponents:     types.Int64Value(device.CriticalComponents),
+			RebootRequired:         types.BoolValue(device.RebootRequired),
+		})
+	}
+
+	state.OutdatedComponents = make([]models.FirmwareOutdatedComponentData, 0, len(summary.OutdatedComponents))
+	for _, component := range summary.OutdatedComponents {
+		state.OutdatedComponents = append(state.OutdatedComponents, models.FirmwareOutdatedComponentData{
+			Name:             types.StringValue(component.Name),
+			ComponentType:    types.StringValue(component.ComponentType),
+			ComplianceStatus: types.StringValue(component.ComplianceStatus),
+			Version:          types.StringValue(component.Version),
+			DeviceCount:      types.Int64Value(component.DeviceCount),
+		})
+	}
+	return nil
+}
@@ -145,6 +145,20 @@ func (jr *JobRunner) GetExecutionDetails(ctx context.Context, executionHistoryID
 	return ed, nil
 }
 
+// GetJobExecutionDetails to get the execution details of the last run of a job.
+func GetJobExecutionDetails(ctx context.Context, omeClient *clients.Client, jobID int64) ([]clients.LastExecutionDetail, error) {
+	jobRunner := JobRunner{client: omeClient, jobID: jobID}
+	led, err := jobRunner.GetLastJobExecution(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ehd, err := jobRunner.GetExecutionDetails(ctx, int64(led.ExecutionHistoryID))
+	if err != nil {
+		return nil, err
+	}
+	return ehd.ExecutionDetails, nil
+}
+
 // DiscoverJobRunner to track the discover job.
 func DiscoverJobRunner(ctx context.Context, omeClient *clients.Client, jobID, timeout int64, partialFailure bool) ([]string, error) {
 	results := make([]string, 0)
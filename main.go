@@ -18,7 +18,12 @@ package main
 import (
 	"context"
 	"flag"
+	"io"
 	"log"
+	"os"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/generator"
 	"terraform-provider-ome/ome"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -27,11 +32,22 @@ import (
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs@v0.19.4 generate --provider-name terraform-provider-ome
 
 func main() {
-	var debug bool
+	var debug, generate bool
+	var kinds, output string
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.BoolVar(&generate, "generate", false, "generate import blocks and resource configuration for the objects of the OME set with the OME_* environment variables")
+	flag.StringVar(&kinds, "kinds", "", "comma separated kinds of objects to generate, all supported kinds when empty: "+strings.Join(generator.SupportedKinds, ","))
+	flag.StringVar(&output, "output", "", "file to write the generated configuration to, stdout when empty")
 	flag.Parse()
 
+	if generate {
+		if err := runGenerator(kinds, output); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	err := providerserver.Serve(context.Background(), ome.New, providerserver.ServeOpts{
 		Address: "registry.terraform.io/dell/ome",
 		Debug:   debug,
@@ -41,3 +57,34 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// runGenerator writes the configuration of the existing OME objects to output
+func runGenerator(kinds string, output string) error {
+	requested, err := generator.ValidateKinds(strings.Split(kinds, ","))
+	if err != nil {
+		return err
+	}
+	opts, err := generator.ClientOptionsFromEnv()
+	if err != nil {
+		return err
+	}
+	omeClient, err := clients.NewClient(opts)
+	if err != nil {
+		return err
+	}
+	if _, err := omeClient.CreateSession(); err != nil {
+		return err
+	}
+	defer omeClient.RemoveSession()
+
+	var w io.Writer = os.Stdout
+	if output != "" {
+		f, err := os.Create(output) // #nosec G304
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return generator.New(omeClient).Generate(w, requested)
+}
@@ -143,6 +143,9 @@ type OmeSingleCatalogResource struct {
 	Domain                 types.String           `tfsdk:"domain"`
 	ShareUser              types.String           `tfsdk:"share_user"`
 	SharePassword          types.String           `tfsdk:"share_password"`
+	TestConnection         types.String           `tfsdk:"test_connection"`
+	WaitForCompletion      types.Bool             `tfsdk:"wait_for_completion"`
+	Timeout                types.Int64            `tfsdk:"timeout"`
 
 	// These are the read only resources of the catalog
 	AssociatedBaselines   types.List   `tfsdk:"associated_baselines"`
@@ -181,6 +184,21 @@ type ScheduleCatalog struct {
 	StartTime types.String `tfsdk:"start_time"`
 }
 
+// RepositoryTestConnectionError - model for the error response of a repository test connection
+type RepositoryTestConnectionError struct {
+	Error struct {
+		Message      string                            `json:"message"`
+		ExtendedInfo []RepositoryTestConnectionMessage `json:"@Message.ExtendedInfo"`
+	} `json:"error"`
+}
+
+// RepositoryTestConnectionMessage - model for a message of a repository test connection error
+type RepositoryTestConnectionMessage struct {
+	MessageID  string `json:"MessageId"`
+	Message    string `json:"Message"`
+	Resolution string `json:"Resolution"`
+}
+
 // CatalogRepository - model for repository tf
 type CatalogRepository struct {
 	BackupExistingCatalog types.Bool   `tfsdk:"backup_existing_catalog"`
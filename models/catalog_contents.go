@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// DellCatalogManifest is the root element of a Dell update catalog, such as Catalog.xml
+type DellCatalogManifest struct {
+	BaseLocation string                 `xml:"baseLocation,attr"`
+	Version      string                 `xml:"version,attr"`
+	ReleaseID    string                 `xml:"releaseID,attr"`
+	DateTime     string                 `xml:"dateTime,attr"`
+	Bundles      []DellCatalogBundle    `xml:"SoftwareBundle"`
+	Components   []DellCatalogComponent `xml:"SoftwareComponent"`
+}
+
+// DellCatalogDisplay is an element of the catalog with a value and its localized display names
+type DellCatalogDisplay struct {
+	Value   string   `xml:"value,attr"`
+	Display []string `xml:"Display"`
+}
+
+// DellCatalogBrand is a brand, such as PowerEdge, and the models of it a bundle or component applies to
+type DellCatalogBrand struct {
+	Key     string             `xml:"key,attr"`
+	Prefix  string             `xml:"prefix,attr"`
+	Display []string           `xml:"Display"`
+	Models  []DellCatalogModel `xml:"Model"`
+}
+
+// DellCatalogModel is a system model identified by its system ID
+type DellCatalogModel struct {
+	SystemID     string   `xml:"systemID,attr"`
+	SystemIDType string   `xml:"systemIDType,attr"`
+	Display      []string `xml:"Display"`
+}
+
+// DellCatalogBundle is a software bundle of the catalog, grouping the components released together for some models
+type DellCatalogBundle struct {
+	ReleaseID     string               `xml:"releaseID,attr"`
+	BundleType    string               `xml:"bundleType,attr"`
+	Path          string               `xml:"path,attr"`
+	VendorVersion string               `xml:"vendorVersion,attr"`
+	DateTime      string               `xml:"dateTime,attr"`
+	Name          DellCatalogDisplay   `xml:"Name"`
+	TargetSystems []DellCatalogBrand   `xml:"TargetSystems>Brand"`
+	Packages      []DellCatalogPackage `xml:"Contents>Package"`
+}
+
+// DellCatalogPackage is a reference to the path of a component contained in a bundle
+type DellCatalogPackage struct {
+	Path string `xml:"path,attr"`
+}
+
+// DellCatalogComponent is a software component, an update package, of the catalog
+type DellCatalogComponent struct {
+	PackageID        string                  `xml:"packageID,attr"`
+	ReleaseID        string                  `xml:"releaseID,attr"`
+	Path             string                  `xml:"path,attr"`
+	VendorVersion    string                  `xml:"vendorVersion,attr"`
+	DellVersion      string                  `xml:"dellVersion,attr"`
+	DateTime         string                  `xml:"dateTime,attr"`
+	ReleaseDate      string                  `xml:"releaseDate,attr"`
+	RebootRequired   string                  `xml:"rebootRequired,attr"`
+	Name             DellCatalogDisplay      `xml:"Name"`
+	ComponentType    DellCatalogDisplay      `xml:"ComponentType"`
+	Category         DellCatalogDisplay      `xml:"Category"`
+	Criticality      DellCatalogDisplay      `xml:"Criticality"`
+	SupportedSystems []DellCatalogBrand      `xml:"SupportedSystems>Brand"`
+	Dependencies     []DellCatalogDependency `xml:"Dependency"`
+}
+
+// DellCatalogDependency is a package a component requires to be installed first
+type DellCatalogDependency struct {
+	PackageID     string   `xml:"packageID,attr"`
+	VendorVersion string   `xml:"vendorVersion,attr"`
+	Display       []string `xml:"Display"`
+}
+
+// CatalogBundleInfo is a bundle of the catalog flattened for reporting
+type CatalogBundleInfo struct {
+	ReleaseID   string
+	Name        string
+	BundleType  string
+	Version     string
+	ReleaseDate string
+	Path        string
+	SystemIDs   []string
+	Models      []string
+	Packages    []string
+}
+
+// CatalogComponentInfo is a component of the catalog flattened for reporting
+type CatalogComponentInfo struct {
+	PackageID      string
+	Name           string
+	ComponentType  string
+	Category       string
+	Version        string
+	DellVersion    string
+	Criticality    string
+	ReleaseDate    string
+	RebootRequired bool
+	Path           string
+	SystemIDs      []string
+	Models         []string
+	Dependencies   []CatalogDependencyInfo
+}
+
+// CatalogDependencyInfo is a dependency of a catalog component flattened for reporting
+type CatalogDependencyInfo struct {
+	PackageID string
+	Name      string
+	Version   string
+}
+
+// CatalogContents holds the config and state data of the catalog contents datasource
+type CatalogContents struct {
+	ID           types.String       `tfsdk:"id"`
+	Path         types.String       `tfsdk:"path"`
+	SystemIDs    types.Set          `tfsdk:"system_ids"`
+	Models       types.Set          `tfsdk:"models"`
+	Version      types.String       `tfsdk:"version"`
+	ReleaseID    types.String       `tfsdk:"release_id"`
+	DateTime     types.String       `tfsdk:"date_time"`
+	BaseLocation types.String       `tfsdk:"base_location"`
+	Bundles      []CatalogBundle    `tfsdk:"bundles"`
+	Components   []CatalogComponent `tfsdk:"components"`
+}
+
+// CatalogBundle holds the state data of a bundle of the catalog
+type CatalogBundle struct {
+	ReleaseID   types.String `tfsdk:"release_id"`
+	Name        types.String `tfsdk:"name"`
+	BundleType  types.String `tfsdk:"bundle_type"`
+	Version     types.String `tfsdk:"version"`
+	ReleaseDate types.String `tfsdk:"release_date"`
+	Path        types.String `tfsdk:"path"`
+	SystemIDs   types.List   `tfsdk:"system_ids"`
+	Models      types.List   `tfsdk:"models"`
+	Packages    types.List   `tfsdk:"packages"`
+}
+
+// CatalogComponent holds the state data of a component of the catalog
+type CatalogComponent struct {
+	PackageID      types.String        `tfsdk:"package_id"`
+	Name           types.String        `tfsdk:"name"`
+	ComponentType  types.String        `tfsdk:"component_type"`
+	Category       types.String        `tfsdk:"category"`
+	Version        types.String        `tfsdk:"version"`
+	DellVersion    types.String        `tfsdk:"dell_version"`
+	Criticality    types.String        `tfsdk:"criticality"`
+	ReleaseDate    types.String        `tfsdk:"release_date"`
+	RebootRequired types.Bool          `tfsdk:"reboot_required"`
+	Path           types.String        `tfsdk:"path"`
+	SystemIDs      types.List          `tfsdk:"system_ids"`
+	Models         types.List          `tfsdk:"models"`
+	Dependencies   []CatalogDependency `tfsdk:"dependencies"`
+}
+
+// CatalogDependency holds the state data of a dependency of a catalog component
+type CatalogDependency struct {
+	PackageID types.String `tfsdk:"package_id"`
+	Name      types.String `tfsdk:"name"`
+	Version   types.String `tfsdk:"version"`
+}
@@ -24,6 +24,8 @@ type ConfigureBaselines struct {
 	BaselineName      types.String `tfsdk:"baseline_name"`
 	DeviceIDs         types.Set    `tfsdk:"device_ids"`
 	DeviceServicetags types.Set    `tfsdk:"device_servicetags"`
+	GroupNames        types.Set    `tfsdk:"group_names"`
+	GroupIDs          types.Set    `tfsdk:"group_ids"`
 	Schedule          types.Bool   `tfsdk:"schedule"`
 	NotifyOnSchedule  types.Bool   `tfsdk:"notify_on_schedule"`
 	EmailAddresses    types.Set    `tfsdk:"email_addresses"`
@@ -116,6 +118,14 @@ type ConfigurationRemediation struct {
 	SleepInterval types.Int64     `tfsdk:"sleep_interval"`
 	RunLater      types.Bool      `tfsdk:"run_later"`
 	Cron          types.String    `tfsdk:"cron"`
+	Enforce       types.Object    `tfsdk:"enforce"`
+}
+
+// ConfigurationEnforce holds the guard rails of the enforce mode of the configuration remediation
+type ConfigurationEnforce struct {
+	MaxDevicesPerApply  types.Int64  `tfsdk:"max_devices_per_apply"`
+	ExcludedServiceTags types.Set    `tfsdk:"excluded_service_tags"`
+	MaintenanceWindow   types.String `tfsdk:"maintenance_window"`
 }
 
 // TargetDevices -  holds the plan data
@@ -160,19 +170,92 @@ type ConfigurationReports struct {
 	ID                     types.String             `tfsdk:"id"`
 	BaseLineName           types.String             `tfsdk:"baseline_name"`
 	FetchAttributes        types.Bool               `tfsdk:"fetch_attributes"`
+	NonCompliantOnly       types.Bool               `tfsdk:"non_compliant_only"`
+	Components             types.Set                `tfsdk:"components"`
+	DeviceServiceTags      types.Set                `tfsdk:"device_servicetags"`
+	DeviceIDs              types.Set                `tfsdk:"device_ids"`
+	RefreshCompliance      types.Bool               `tfsdk:"refresh_compliance"`
+	RefreshTimeout         types.Int64              `tfsdk:"refresh_timeout"`
 	ComplianceReportDevice []ComplianceReportDevice `tfsdk:"compliance_report_device"`
 }
 
 // ComplianceReportDevice holds device report
 type ComplianceReportDevice struct {
-	DeviceID                types.Int64  `tfsdk:"device_id"`
-	DeviceName              types.String `tfsdk:"device_name"`
-	Model                   types.String `tfsdk:"model"`
-	DeviceServiceTag        types.String `tfsdk:"device_servicetag"`
-	ComplianceStatus        types.String `tfsdk:"compliance_status"`
-	DeviceType              types.Int64  `tfsdk:"device_type"`
-	InventoryTime           types.String `tfsdk:"inventory_time"`
-	DeviceComplianceDetails types.String `tfsdk:"device_compliance_details"`
+	DeviceID                types.Int64                 `tfsdk:"device_id"`
+	DeviceName              types.String                `tfsdk:"device_name"`
+	Model                   types.String                `tfsdk:"model"`
+	DeviceServiceTag        types.String                `tfsdk:"device_servicetag"`
+	ComplianceStatus        types.String                `tfsdk:"compliance_status"`
+	DeviceType              types.Int64                 `tfsdk:"device_type"`
+	InventoryTime           types.String                `tfsdk:"inventory_time"`
+	DeviceComplianceDetails types.String                `tfsdk:"device_compliance_details"`
+	ComplianceComponents    []ComplianceReportComponent `tfsdk:"compliance_components"`
+}
+
+// ComplianceReportComponent holds the attribute compliance report of a device component
+type ComplianceReportComponent struct {
+	Component        types.String                `tfsdk:"component"`
+	ComplianceStatus types.String                `tfsdk:"compliance_status"`
+	ComplianceReason types.String                `tfsdk:"compliance_reason"`
+	Attributes       []ComplianceReportAttribute `tfsdk:"attributes"`
+}
+
+// ComplianceReportAttribute holds the compliance report of a device attribute
+type ComplianceReportAttribute struct {
+	Group            types.String `tfsdk:"group"`
+	AttributeID      types.Int64  `tfsdk:"attribute_id"`
+	AttributeName    types.String `tfsdk:"attribute_name"`
+	ExpectedValue    types.String `tfsdk:"expected_value"`
+	CurrentValue     types.String `tfsdk:"current_value"`
+	ComplianceStatus types.String `tfsdk:"compliance_status"`
+	ComplianceReason types.String `tfsdk:"compliance_reason"`
+}
+
+// OMEDeviceComplianceDetail - attribute compliance report of a device
+type OMEDeviceComplianceDetail struct {
+	DeviceID                  int64                         `json:"DeviceId"`
+	DeviceName                string                        `json:"DeviceName"`
+	BaselineID                int64                         `json:"BaselineId"`
+	BaselineName              string                        `json:"BaselineName"`
+	TemplateID                int64                         `json:"TemplateId"`
+	TemplateName              string                        `json:"TemplateName"`
+	ComplianceAttributeGroups []OMEComplianceAttributeGroup `json:"ComplianceAttributeGroups"`
+}
+
+// OMEComplianceAttributeGroup - compliance of a group of attributes, the top level groups are the device components
+type OMEComplianceAttributeGroup struct {
+	GroupNameID                  int64                         `json:"GroupNameId"`
+	DisplayName                  string                        `json:"DisplayName"`
+	ComplianceStatus             int64                         `json:"ComplianceStatus"`
+	ComplianceReason             string                        `json:"ComplianceReason"`
+	ComplianceSubAttributeGroups []OMEComplianceAttributeGroup `json:"ComplianceSubAttributeGroups"`
+	Attributes                   []OMEComplianceAttribute      `json:"Attributes"`
+}
+
+// OMEComplianceAttribute - compliance of an attribute
+type OMEComplianceAttribute struct {
+	AttributeID      int64  `json:"AttributeId"`
+	CustomID         int64  `json:"CustomId"`
+	DisplayName      string `json:"DisplayName"`
+	Description      string `json:"Description"`
+	Value            string `json:"Value"`
+	ExpectedValue    string `json:"ExpectedValue"`
+	ComplianceStatus int64  `json:"ComplianceStatus"`
+	ComplianceReason string `json:"ComplianceReason"`
+}
+
+// OMEComplianceComponent - attribute compliance of a device component with the attributes of its sub groups
+type OMEComplianceComponent struct {
+	Name             string
+	ComplianceStatus int64
+	ComplianceReason string
+	Attributes       []OMEComplianceComponentAttribute
+}
+
+// OMEComplianceComponentAttribute - compliance of an attribute with the path of its sub group in the component
+type OMEComplianceComponentAttribute struct {
+	Group string
+	OMEComplianceAttribute
 }
 
 // OMEComplianceReports - ome compliance report
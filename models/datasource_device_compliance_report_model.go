@@ -89,11 +89,18 @@ type ComponentComplianceReportModel struct {
 
 // OMEDeviceComplianceData represents the OME Device Compliance
 type OMEDeviceComplianceData struct {
-	ID                types.Int64            `tfsdk:"id"`
-	Reports           []DeviceComplianceData `tfsdk:"device_compliance_reports"`
-	DeviceIDs         types.List             `tfsdk:"device_ids"`
-	DeviceServiceTags types.List             `tfsdk:"device_service_tags"`
-	DeviceGroupNames  types.List             `tfsdk:"device_group_names"`
+	ID                types.Int64                  `tfsdk:"id"`
+	Reports           []DeviceComplianceData       `tfsdk:"device_compliance_reports"`
+	DeviceIDs         types.List                   `tfsdk:"device_ids"`
+	DeviceServiceTags types.List                   `tfsdk:"device_service_tags"`
+	DeviceGroupNames  types.List                   `tfsdk:"device_group_names"`
+	BaselineRules     []BaselineComponentRulesData `tfsdk:"baseline_component_rules"`
+}
+
+// BaselineComponentRulesData - component rules of a firmware baseline applied to the device compliance reports
+type BaselineComponentRulesData struct {
+	BaselineName   types.String                 `tfsdk:"baseline_name"`
+	ComponentRules []FirmwareComponentRuleModel `tfsdk:"component_rules"`
 }
 
 // DeviceComplianceData - The representation of Device Compliance
@@ -34,6 +34,48 @@ type TemplateDeployment struct {
 	OptionsContinueOnWarning        types.Bool   `tfsdk:"options_continue_on_warning"`
 	RunLater                        types.Bool   `tfsdk:"run_later"`
 	Cron                            types.String `tfsdk:"cron"`
+	Rollout                         types.Object `tfsdk:"rollout"`
+	DeploymentResults               types.List   `tfsdk:"deployment_results"`
+	SlotTargets                     types.Set    `tfsdk:"slot_targets"`
+	SlotStatus                      types.List   `tfsdk:"slot_status"`
+}
+
+// SlotTarget to hold planned and state data of a chassis slot targeted by a deployment
+type SlotTarget struct {
+	ChassisServicetag types.String `tfsdk:"chassis_servicetag"`
+	SlotNumber        types.Int64  `tfsdk:"slot_number"`
+}
+
+// SlotStatus to hold the state data of the profile assigned to a chassis slot
+type SlotStatus struct {
+	ChassisServicetag types.String `tfsdk:"chassis_servicetag"`
+	SlotNumber        types.Int64  `tfsdk:"slot_number"`
+	SlotID            types.Int64  `tfsdk:"slot_id"`
+	ProfileID         types.Int64  `tfsdk:"profile_id"`
+	ProfileName       types.String `tfsdk:"profile_name"`
+	Occupied          types.Bool   `tfsdk:"occupied"`
+	SledServicetag    types.String `tfsdk:"sled_servicetag"`
+	ProfileApplied    types.Bool   `tfsdk:"profile_applied"`
+}
+
+// DeploymentRollout to hold planned and state data for a batched deployment
+type DeploymentRollout struct {
+	BatchSize            types.Int64 `tfsdk:"batch_size"`
+	BatchPercentage      types.Int64 `tfsdk:"batch_percentage"`
+	PauseBetweenBatches  types.Int64 `tfsdk:"pause_between_batches"`
+	HealthCheck          types.Bool  `tfsdk:"health_check"`
+	MaxFailures          types.Int64 `tfsdk:"max_failures"`
+	MaxFailurePercentage types.Int64 `tfsdk:"max_failure_percentage"`
+}
+
+// DeploymentResult to hold the state data of the deployment on a single device
+type DeploymentResult struct {
+	DeviceID   types.Int64  `tfsdk:"device_id"`
+	ServiceTag types.String `tfsdk:"service_tag"`
+	Batch      types.Int64  `tfsdk:"batch"`
+	Status     types.String `tfsdk:"status"`
+	ProfileID  types.Int64  `tfsdk:"profile_id"`
+	Message    types.String `tfsdk:"message"`
 }
 
 // BootToNetworkISO to hold planned and state data for boot info
@@ -147,14 +189,96 @@ type OMEServerProfiles struct {
 
 // OMEServerProfile to form a profile type object.
 type OMEServerProfile struct {
-	ID           int64  `json:"Id,omitempty"`
-	ProfileName  string `json:"ProfileName,omitempty"`
-	TemplateID   int64  `json:"TemplateId,omitempty"`
-	TemplateName string `json:"TemplateName,omitempty"`
-	TargetID     int64  `json:"TargetId,omitempty"`
+	ID               int64  `json:"Id,omitempty"`
+	ProfileName      string `json:"ProfileName,omitempty"`
+	TemplateID       int64  `json:"TemplateId,omitempty"`
+	TemplateName     string `json:"TemplateName,omitempty"`
+	TargetID         int64  `json:"TargetId,omitempty"`
+	DeviceIDInSlot   int64  `json:"DeviceIdInSlot,omitempty"`
+	DeploymentTaskID int64  `json:"DeploymentTaskId,omitempty"`
+	LastRunStatus    int64  `json:"LastRunStatus,omitempty"`
+}
+
+// OMEProfileCreateRequest to create unassigned profiles from a template.
+type OMEProfileCreateRequest struct {
+	TemplateID               int64  `json:"TemplateId"`
+	NamePrefix               string `json:"NamePrefix"`
+	NumberOfProfilesToCreate int64  `json:"NumberOfProfilesToCreate"`
+}
+
+// OMEProfileAssignRequest to assign a profile to a device or a chassis slot.
+type OMEProfileAssignRequest struct {
+	ID       int64 `json:"Id"`
+	TargetID int64 `json:"TargetId"`
+}
+
+// OMEChassisSlots to parse the slots inventory of a chassis.
+type OMEChassisSlots struct {
+	InventoryInfo []OMEChassisSlot `json:"InventoryInfo"`
+}
+
+// OMEChassisSlot to form a chassis slot type object.
+type OMEChassisSlot struct {
+	ID         int64  `json:"Id"`
+	SlotNumber string `json:"SlotNumber"`
+	SlotName   string `json:"SlotName"`
+	SlotType   int64  `json:"SlotType"`
+	DeviceID   int64  `json:"DeviceId"`
 }
 
 // ProfileDeleteRequest to delete profiles type request.
 type ProfileDeleteRequest struct {
 	ProfileIds []int64 `json:"ProfileIds"`
 }
+
+// OMEDeploymentPrecheckResult to hold the pre-check result of a device parsed from the job execution details
+type OMEDeploymentPrecheckResult struct {
+	DeviceID          int64
+	Key               string
+	Status            string
+	Passed            bool
+	RebootRequired    bool
+	Messages          []string
+	AttributeMessages []OMEPrecheckAttributeMessage
+	VlanConflicts     []string
+}
+
+// OMEPrecheckAttributeMessage to hold a pre-check message about a template attribute
+type OMEPrecheckAttributeMessage struct {
+	Attribute string
+	Message   string
+}
+
+// DeploymentPrecheck to hold the config and state data of the deployment pre-check datasource
+type DeploymentPrecheck struct {
+	ID                        types.String               `tfsdk:"id"`
+	TemplateID                types.Int64                `tfsdk:"template_id"`
+	TemplateName              types.String               `tfsdk:"template_name"`
+	DeviceIDs                 types.Set                  `tfsdk:"device_ids"`
+	DeviceServicetags         types.Set                  `tfsdk:"device_servicetags"`
+	OptionsStrictCheckingVlan types.Bool                 `tfsdk:"options_strict_checking_vlan"`
+	JobRetryCount             types.Int64                `tfsdk:"job_retry_count"`
+	SleepInterval             types.Int64                `tfsdk:"sleep_interval"`
+	JobID                     types.Int64                `tfsdk:"job_id"`
+	Passed                    types.Bool                 `tfsdk:"passed"`
+	RebootRequired            types.Bool                 `tfsdk:"reboot_required"`
+	Devices                   []DeploymentPrecheckDevice `tfsdk:"devices"`
+}
+
+// DeploymentPrecheckDevice to hold the state data of the pre-check of a device
+type DeploymentPrecheckDevice struct {
+	DeviceID          types.Int64                          `tfsdk:"device_id"`
+	ServiceTag        types.String                         `tfsdk:"service_tag"`
+	Status            types.String                         `tfsdk:"status"`
+	Passed            types.Bool                           `tfsdk:"passed"`
+	RebootRequired    types.Bool                           `tfsdk:"reboot_required"`
+	Messages          []types.String                       `tfsdk:"messages"`
+	AttributeMessages []DeploymentPrecheckAttributeMessage `tfsdk:"attribute_messages"`
+	VlanConflicts     []types.String                       `tfsdk:"vlan_conflicts"`
+}
+
+// DeploymentPrecheckAttributeMessage to hold the state data of a pre-check message about a template attribute
+type DeploymentPrecheckAttributeMessage struct {
+	Attribute types.String `tfsdk:"attribute"`
+	Message   types.String `tfsdk:"message"`
+}
@@ -33,3 +33,35 @@ type DeviceActionModel struct {
 	StartTime      types.String `tfsdk:"start_time"`
 	EndTime        types.String `tfsdk:"end_time"`
 }
+
+// DeviceJobAction - arguments of the actions which run a job on devices
+type DeviceJobAction struct {
+	DeviceIDs         []int64      `tfsdk:"device_ids"`
+	DeviceServiceTags []string     `tfsdk:"device_service_tags"`
+	JobName           types.String `tfsdk:"job_name"`
+	JobDescription    types.String `tfsdk:"job_description"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
+}
+
+// DevicePowerAction - arguments of the device power action
+type DevicePowerAction struct {
+	DeviceIDs         []int64      `tfsdk:"device_ids"`
+	DeviceServiceTags []string     `tfsdk:"device_service_tags"`
+	PowerState        types.String `tfsdk:"power_state"`
+	JobName           types.String `tfsdk:"job_name"`
+	JobDescription    types.String `tfsdk:"job_description"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
+}
+
+// ComplianceCheckAction - arguments of the baseline compliance check action
+type ComplianceCheckAction struct {
+	ConfigurationBaselineName types.String `tfsdk:"configuration_baseline_name"`
+	FirmwareBaselineName      types.String `tfsdk:"firmware_baseline_name"`
+	Timeout                   types.Int64  `tfsdk:"timeout"`
+}
+
+// CatalogRefreshAction - arguments of the firmware catalog refresh action
+type CatalogRefreshAction struct {
+	CatalogNames []string    `tfsdk:"catalog_names"`
+	Timeout      types.Int64 `tfsdk:"timeout"`
+}
@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// OMEIdentitySet is an identity set, such as Ethernet, iSCSI, FCoE or FC, of an identity pool
+type OMEIdentitySet struct {
+	IdentitySetID int64  `json:"IdentitySetId"`
+	Name          string `json:"Name"`
+}
+
+// OMEIdentityUsage is an identity of an identity pool assigned to a NIC partition
+type OMEIdentityUsage struct {
+	IdentityType  string                `json:"IdentityType"`
+	ChassisName   string                `json:"ChassisName"`
+	ServerName    string                `json:"ServerName"`
+	ProfileName   string                `json:"ProfileName"`
+	NicIdentifier string                `json:"NicIdentifier"`
+	MacAddress    string                `json:"MacAddress"`
+	IQN           string                `json:"Iqn"`
+	WWPN          string                `json:"Wwpn"`
+	WWNN          string                `json:"Wwnn"`
+	DeviceInfo    OMEIdentityDeviceInfo `json:"DeviceInfo"`
+}
+
+// OMEIdentityDeviceInfo is the device an identity is assigned to
+type OMEIdentityDeviceInfo struct {
+	ID         int64  `json:"Id"`
+	ServiceTag string `json:"ServiceTag"`
+}
+
+// OMEDeviceIdentity holds the identities assigned to a NIC partition of a device
+type OMEDeviceIdentity struct {
+	DeviceID    int64
+	ServiceTag  string
+	ProfileName string
+	NicID       string
+	Partition   int64
+	EthernetMac string
+	IscsiMac    string
+	IscsiIQN    string
+	FcoeMac     string
+	FcoeWWPN    string
+	FcoeWWNN    string
+	FcWWPN      string
+	FcWWNN      string
+}
+
+// DeviceIdentities holds the config and state data of the device identities datasource
+type DeviceIdentities struct {
+	ID                types.String     `tfsdk:"id"`
+	TemplateID        types.Int64      `tfsdk:"template_id"`
+	TemplateName      types.String     `tfsdk:"template_name"`
+	DeviceIDs         types.Set        `tfsdk:"device_ids"`
+	DeviceServicetags types.Set        `tfsdk:"device_servicetags"`
+	IdentityPoolID    types.Int64      `tfsdk:"identity_pool_id"`
+	IdentityPoolName  types.String     `tfsdk:"identity_pool_name"`
+	Identities        []DeviceIdentity `tfsdk:"identities"`
+}
+
+// DeviceIdentity holds the state data of the identities assigned to a NIC partition of a device
+type DeviceIdentity struct {
+	DeviceID    types.Int64  `tfsdk:"device_id"`
+	ServiceTag  types.String `tfsdk:"service_tag"`
+	ProfileName types.String `tfsdk:"profile_name"`
+	NicID       types.String `tfsdk:"nic_id"`
+	Partition   types.Int64  `tfsdk:"partition"`
+	EthernetMac types.String `tfsdk:"ethernet_mac"`
+	IscsiMac    types.String `tfsdk:"iscsi_mac"`
+	IscsiIQN    types.String `tfsdk:"iscsi_iqn"`
+	FcoeMac     types.String `tfsdk:"fcoe_mac"`
+	FcoeWWPN    types.String `tfsdk:"fcoe_wwpn"`
+	FcoeWWNN    types.String `tfsdk:"fcoe_wwnn"`
+	FcWWPN      types.String `tfsdk:"fc_wwpn"`
+	FcWWNN      types.String `tfsdk:"fc_wwnn"`
+}
@@ -90,6 +90,27 @@ type FirmwareBaselineResource struct {
 	DeviceNames            types.List   `tfsdk:"device_names"`
 	DeviceServiceTags      types.List   `tfsdk:"device_service_tags"`
 	GroupNames             types.List   `tfsdk:"group_names"`
+	WaitForCompletion      types.Bool   `tfsdk:"wait_for_completion"`
+	Timeout                types.Int64  `tfsdk:"timeout"`
+	ComponentRules         types.List   `tfsdk:"component_rules"`
+}
+
+// FirmwareComponentRule - rule pinning a firmware component to a version or excluding it from a baseline
+type FirmwareComponentRule struct {
+	// Pin or Exclude
+	Action string
+	// source name of the component, may contain * wildcards
+	SourceName  string
+	ComponentID string
+	Version     string
+}
+
+// FirmwareComponentRuleModel - tfsdk model of a firmware component rule
+type FirmwareComponentRuleModel struct {
+	Action      types.String `tfsdk:"action"`
+	SourceName  types.String `tfsdk:"source_name"`
+	ComponentID types.String `tfsdk:"component_id"`
+	Version     types.String `tfsdk:"version"`
 }
 
 // CreateUpdateFirmwareBaseline - payload to create/update a firmware baseline
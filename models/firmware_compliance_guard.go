@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+// FirmwareComplianceGuard - firmware compliance the devices targeted by a resource must meet before it is applied
+type FirmwareComplianceGuard struct {
+	// lowest compliance status tripping the guard
+	Severity string
+	// warning or error
+	Policy string
+	// baselines the compliance is read from, all baselines when empty
+	BaselineNames []string
+	// component rules applied to the compliance reports, keyed by baseline name
+	ComponentRules map[string][]FirmwareComponentRule
+}
+
+// FirmwareComplianceViolation - device whose firmware compliance against a baseline trips the firmware compliance guard
+type FirmwareComplianceViolation struct {
+	DeviceID         int64
+	ServiceTag       string
+	DeviceName       string
+	BaselineName     string
+	ComplianceStatus string
+	// components at least as severe as the guard
+	Components []ComponentComplianceReportModel
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// FirmwareComplianceSummary - firmware compliance aggregated across the devices of one or more baselines
+type FirmwareComplianceSummary struct {
+	TotalDevices             int64
+	StatusCounts             map[string]int64
+	RebootRequiredDevices    int64
+	RebootRequiredPercentage float64
+	// devices out of compliance, the most severe first
+	WorstDevices []FirmwareComplianceDevice
+	// components needing an upgrade, on the most devices first
+	OutdatedComponents []FirmwareOutdatedComponent
+}
+
+// FirmwareComplianceDevice - firmware compliance of a device across the baselines targeting it
+type FirmwareComplianceDevice struct {
+	DeviceID               int64
+	DeviceName             string
+	ServiceTag             string
+	DeviceModel            string
+	ComplianceStatus       string
+	BaselineNames          []string
+	NonCompliantComponents int64
+	CriticalComponents     int64
+	RebootRequired         bool
+}
+
+// FirmwareOutdatedComponent - firmware component needing an upgrade on one or more devices
+type FirmwareOutdatedComponent struct {
+	Name             string
+	ComponentType    string
+	ComplianceStatus string
+	Version          string
+	DeviceCount      int64
+}
+
+// FirmwareComplianceSummaryData - tfsdk model of the firmware compliance summary data source
+type FirmwareComplianceSummaryData struct {
+	ID                       types.Int64                     `tfsdk:"id"`
+	BaselineNames            types.List                      `tfsdk:"baseline_names"`
+	DeviceGroupNames         types.List                      `tfsdk:"device_group_names"`
+	DeviceModels             types.List                      `tfsdk:"device_models"`
+	BaselineRules            []BaselineComponentRulesData    `tfsdk:"baseline_component_rules"`
+	WorstDevicesLimit        types.Int64                     `tfsdk:"worst_devices_limit"`
+	OutdatedComponentsLimit  types.Int64                     `tfsdk:"outdated_components_limit"`
+	TotalDevices             types.Int64                     `tfsdk:"total_devices"`
+	StatusCounts             FirmwareStatusCountsData        `tfsdk:"status_counts"`
+	RebootRequiredDevices    types.Int64                     `tfsdk:"reboot_required_devices"`
+	RebootRequiredPercentage types.Float64                   `tfsdk:"reboot_required_percentage"`
+	WorstDevices             []FirmwareComplianceDeviceData  `tfsdk:"worst_devices"`
+	OutdatedComponents       []FirmwareOutdatedComponentData `tfsdk:"outdated_components"`
+}
+
+// FirmwareStatusCountsData - number of devices per firmware compliance status
+type FirmwareStatusCountsData struct {
+	Ok        types.Int64 `tfsdk:"ok"`
+	Warning   types.Int64 `tfsdk:"warning"`
+	Critical  types.Int64 `tfsdk:"critical"`
+	Downgrade types.Int64 `tfsdk:"downgrade"`
+	Unknown   types.Int64 `tfsdk:"unknown"`
+}
+
+// FirmwareComplianceDeviceData - tfsdk model of the firmware compliance of a device
+type FirmwareComplianceDeviceData struct {
+	DeviceID               types.Int64  `tfsdk:"device_id"`
+	DeviceName             types.String `tfsdk:"device_name"`
+	ServiceTag             types.String `tfsdk:"service_tag"`
+	DeviceModel            types.String `tfsdk:"device_model"`
+	ComplianceStatus       types.String `tfsdk:"compliance_status"`
+	BaselineNames          types.List   `tfsdk:"baseline_names"`
+	NonCompliantComponents types.Int64  `tfsdk:"non_compliant_components"`
+	CriticalComponents     types.Int64  `tfsdk:"critical_components"`
+	RebootRequired         types.Bool   `tfsdk:"reboot_required"`
+}
+
+// FirmwareOutdatedComponentData - tfsdk model of a firmware component needing an upgrade
+type FirmwareOutdatedComponentData struct {
+	Name             types.String `tfsdk:"name"`
+	ComponentType    types.String `tfsdk:"component_type"`
+	ComplianceStatus types.String `tfsdk:"compliance_status"`
+	Version          types.String `tfsdk:"version"`
+	DeviceCount      types.Int64  `tfsdk:"device_count"`
+}
@@ -91,11 +91,13 @@ const (
 	ResetIDRACJobType
 	// ClearJobQueueJobType - iDrac job queue clear job type
 	ClearJobQueueJobType
+	// PowerControlJobType - device power control job type
+	PowerControlJobType
 )
 
 // MarshalJSON - implements marshaller interface
 func (j JobType) MarshalJSON() ([]byte, error) {
-	jobTypeMap := map[JobType]uint8{InventoryRefreshJobType: 8, ResetIDRACJobType: 3, ClearJobQueueJobType: 3}
+	jobTypeMap := map[JobType]uint8{InventoryRefreshJobType: 8, ResetIDRACJobType: 3, ClearJobQueueJobType: 3, PowerControlJobType: 3}
 	jtypeMap := map[uint8]string{3: "DeviceAction_Task", 8: "Inventory_Task"}
 
 	return json.Marshal(&struct {
@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-ome/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = SCPContentType{}
+	_ basetypes.StringValuableWithSemanticEquals = SCPContent{}
+)
+
+// SCPContentType - string type of the SCP XML contents, whose values are equal when they hold the same SCP
+type SCPContentType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the given type is equivalent
+func (t SCPContentType) Equal(o attr.Type) bool {
+	other, ok := o.(SCPContentType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// String returns a human readable string of the type name
+func (t SCPContentType) String() string {
+	return "SCPContentType"
+}
+
+// ValueFromString returns a StringValuable type given a StringValue
+func (t SCPContentType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SCPContent{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value
+func (t SCPContentType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+// ValueType returns the Value type
+func (t SCPContentType) ValueType(_ context.Context) attr.Value {
+	return SCPContent{}
+}
+
+// SCPContent - SCP XML content, cosmetic changes like whitespaces, ordering or export timestamp are not differences
+type SCPContent struct {
+	basetypes.StringValue
+}
+
+// NewSCPContentValue returns a known SCP content
+func NewSCPContentValue(value string) SCPContent {
+	return SCPContent{StringValue: basetypes.NewStringValue(value)}
+}
+
+// Equal returns true if the given value is equivalent
+func (v SCPContent) Equal(o attr.Value) bool {
+	other, ok := o.(SCPContent)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns the type of the value
+func (v SCPContent) Type(_ context.Context) attr.Type {
+	return SCPContentType{}
+}
+
+// StringSemanticEquals returns true when both values hold the same SCP once normalized
+func (v SCPContent) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(SCPContent)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("An unexpected value type was received while performing semantic equality checks. Expected Value Type: %T, Got Value Type: %T", v, newValuable),
+		)
+		return false, diags
+	}
+	return utils.EquivalentSCP(v.ValueString(), newValue.ValueString()), diags
+}
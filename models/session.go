@@ -0,0 +1,29 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Session - ephemeral OME session
+type Session struct {
+	Token     types.String `tfsdk:"token"`
+	SessionID types.String `tfsdk:"session_id"`
+	BaseURL   types.String `tfsdk:"base_url"`
+}
+
+// SessionPrivateData - session details kept by terraform between the open and the close of the session
+type SessionPrivateData struct {
+	Token     string `json:"token"`
+	SessionID string `json:"session_id"`
+}
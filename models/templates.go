@@ -42,12 +42,13 @@ type Template struct {
 	ReftemplateName     types.String `tfsdk:"reftemplate_name"`
 	Description         types.String `tfsdk:"description"`
 	Attributes          types.List   `tfsdk:"attributes"`
+	AttributeValues     types.Map    `tfsdk:"attribute_values"`
 	JobRetryCount       types.Int64  `tfsdk:"job_retry_count"`
 	SleepInterval       types.Int64  `tfsdk:"sleep_interval"`
 	IdentityPoolName    types.String `tfsdk:"identity_pool_name"`
 	IdentityPoolID      types.Int64  `tfsdk:"identity_pool_id"`
 	Vlan                types.Object `tfsdk:"vlan"`
-	Content             types.String `tfsdk:"content"`
+	Content             SCPContent   `tfsdk:"content"`
 }
 
 // Attribute template attributes
@@ -156,6 +157,7 @@ type OMETemplate struct {
 	TaskID               int64             `json:"TaskId"`
 	Status               int64             `json:"Status"`
 	IdentityPoolID       int64             `json:"IdentityPoolId"`
+	CreatedBy            string            `json:"CreatedBy"`
 	ViewsNavigationLink  string            `json:"Views@odata.navigationLink"`
 	AttributeDetailsLink map[string]string `json:"AttributeDetails"`
 }
@@ -243,6 +245,68 @@ type OMECloneTemplate struct {
 	ViewTypeID       int64  `json:"ViewTypeId"`
 }
 
+// OMEExportTemplate - model used to export the content of a template.
+type OMEExportTemplate struct {
+	TemplateID int64  `json:"TemplateId"`
+	Format     string `json:"Format"`
+}
+
+// OMEExportTemplateResponse - exported content of a template.
+type OMEExportTemplateResponse struct {
+	TemplateID  int64  `json:"TemplateId"`
+	Content     string `json:"Content"`
+	ContentType string `json:"ContentType"`
+}
+
+// TemplateExport - tfsdk model of the template export data source.
+type TemplateExport struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	RedactPasswords types.Bool   `tfsdk:"redact_passwords"`
+	FQDDs           types.List   `tfsdk:"fqdds"`
+	Content         types.String `tfsdk:"content"`
+	Attributes      types.Map    `tfsdk:"attributes"`
+}
+
+// TemplateAttributeDifference - attribute of a template differing from another template
+type TemplateAttributeDifference struct {
+	DisplayName string
+	SourceValue string
+	TargetValue string
+}
+
+// TemplateAttributesDiff - differences between the attributes of two templates
+type TemplateAttributesDiff struct {
+	Added   []TemplateAttributeDifference
+	Removed []TemplateAttributeDifference
+	Changed []TemplateAttributeDifference
+}
+
+// TemplateDiff - tfsdk model of the template diff data source.
+type TemplateDiff struct {
+	ID                     types.String            `tfsdk:"id"`
+	SourceTemplateName     types.String            `tfsdk:"source_template_name"`
+	TargetTemplateName     types.String            `tfsdk:"target_template_name"`
+	TargetDeviceID         types.Int64             `tfsdk:"target_device_id"`
+	TargetDeviceServicetag types.String            `tfsdk:"target_device_servicetag"`
+	FQDDS                  types.String            `tfsdk:"fqdds"`
+	IncludeIgnored         types.Bool              `tfsdk:"include_ignored"`
+	JobRetryCount          types.Int64             `tfsdk:"job_retry_count"`
+	SleepInterval          types.Int64             `tfsdk:"sleep_interval"`
+	HasDifferences         types.Bool              `tfsdk:"has_differences"`
+	Added                  []TemplateDiffAttribute `tfsdk:"added"`
+	Removed                []TemplateDiffAttribute `tfsdk:"removed"`
+	Changed                []TemplateDiffAttribute `tfsdk:"changed"`
+}
+
+// TemplateDiffAttribute - tfsdk model of an attribute differing between two templates.
+type TemplateDiffAttribute struct {
+	Path        types.String `tfsdk:"path"`
+	Component   types.String `tfsdk:"component"`
+	SourceValue types.String `tfsdk:"source_value"`
+	TargetValue types.String `tfsdk:"target_value"`
+}
+
 // OMEImportTemplate - model used to clone template from a reference template id.
 type OMEImportTemplate struct {
 	ViewTypeID int64  `json:"ViewTypeId"`
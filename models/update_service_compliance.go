@@ -122,7 +122,8 @@ type OmeFwComplianceReportData struct {
 	BaseLineName types.String            `tfsdk:"baseline_name"`
 	Report       []OmeFwComplianceReport `tfsdk:"firmware_compliance_reports"`
 	//filter
-	CrFilter *OmeFwComplianceReportFilter `tfsdk:"filter"`
+	CrFilter       *OmeFwComplianceReportFilter `tfsdk:"filter"`
+	ComponentRules []FirmwareComponentRuleModel `tfsdk:"component_rules"`
 }
 
 // OmeFwComplianceReportFilter the model 'OmeFwComplianceReportFilter'
@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &complianceCheckAction{}
+	_ action.ActionWithConfigure = &complianceCheckAction{}
+)
+
+// NewComplianceCheckAction is a new action to re-check the compliance of a baseline
+func NewComplianceCheckAction() action.Action {
+	return &complianceCheckAction{}
+}
+
+type complianceCheckAction struct {
+	p *omeProvider
+}
+
+// Configure implements action.ActionWithConfigure
+func (a *complianceCheckAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.p = configureAction(req)
+}
+
+// Metadata implements action.Action
+func (a *complianceCheckAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "compliance_check"
+}
+
+// Schema implements action.Action
+func (a *complianceCheckAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform action is used to re-check the compliance of a configuration or firmware baseline on OME." +
+			" The action runs the compliance task of the baseline and waits for it to complete.",
+		Description: "This terraform action is used to re-check the compliance of a configuration or firmware baseline on OME." +
+			" The action runs the compliance task of the baseline and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"configuration_baseline_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the configuration baseline." +
+					" Conflicts with `firmware_baseline_name`.",
+				Description: "Name of the configuration baseline." +
+					" Conflicts with 'firmware_baseline_name'.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("firmware_baseline_name")),
+				},
+			},
+			"firmware_baseline_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the firmware baseline." +
+					" Conflicts with `configuration_baseline_name`.",
+				Description: "Name of the firmware baseline." +
+					" Conflicts with 'configuration_baseline_name'.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"timeout": actionTimeoutAttribute(),
+		},
+	}
+}
+
+// Invoke implements action.Action
+func (a *complianceCheckAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Trace(ctx, "action_compliance_check invoke: started")
+	var config models.ComplianceCheckAction
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	omeClient, d := a.p.createOMESession(ctx, "action_compliance_check Invoke")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	taskID, err := getComplianceTaskID(omeClient, config)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrComplianceCheck, err.Error())
+		return
+	}
+
+	// the task keeps the status of its previous run till the new run starts
+	task, err := omeClient.GetJob(taskID)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrComplianceCheck, err.Error())
+		return
+	}
+
+	if err := omeClient.RunJobs([]int64{taskID}); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrComplianceCheck, err.Error())
+		return
+	}
+
+	if err := trackActionJob(ctx, omeClient, taskID, &task.LastRun, config.Timeout, resp); err != nil {
+		resp.Diagnostics.AddError("Compliance check job could not complete.", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "action_compliance_check invoke: finished")
+}
+
+// getComplianceTaskID returns the ID of the task computing the compliance of the baseline
+func getComplianceTaskID(omeClient *clients.Client, config models.ComplianceCheckAction) (int64, error) {
+	if !config.ConfigurationBaselineName.IsNull() {
+		baseline, err := omeClient.GetBaselineByName(config.ConfigurationBaselineName.ValueString())
+		if err != nil {
+			return 0, err
+		}
+		return baseline.TaskID, nil
+	}
+	name := config.FirmwareBaselineName.ValueString()
+	baseline, err := omeClient.GetFirmwareBaselineWithName(name)
+	if err != nil {
+		return 0, err
+	}
+	if baseline.TaskID == nil {
+		return 0, fmt.Errorf("firmware baseline %s could not be found", name)
+	}
+	return *baseline.TaskID, nil
+}
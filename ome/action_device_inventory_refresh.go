@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultInventoryRefreshJobName = "Inventory refresh"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &deviceInventoryRefreshAction{}
+	_ action.ActionWithConfigure = &deviceInventoryRefreshAction{}
+)
+
+// NewDeviceInventoryRefreshAction is a new action to refresh the inventory of devices
+func NewDeviceInventoryRefreshAction() action.Action {
+	return &deviceInventoryRefreshAction{}
+}
+
+type deviceInventoryRefreshAction struct {
+	p *omeProvider
+}
+
+// Configure implements action.ActionWithConfigure
+func (a *deviceInventoryRefreshAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.p = configureAction(req)
+}
+
+// Metadata implements action.Action
+func (a *deviceInventoryRefreshAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "device_inventory_refresh"
+}
+
+// Schema implements action.Action
+func (a *deviceInventoryRefreshAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform action is used to refresh the inventory of devices managed by OME. The action creates an inventory refresh job on OME and waits for it to complete.",
+		Description:         "This terraform action is used to refresh the inventory of devices managed by OME. The action creates an inventory refresh job on OME and waits for it to complete.",
+		Attributes:          deviceJobActionAttributes(defaultInventoryRefreshJobName),
+	}
+}
+
+// Invoke implements action.Action
+func (a *deviceInventoryRefreshAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Trace(ctx, "action_device_inventory_refresh invoke: started")
+	var config models.DeviceJobAction
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	omeClient, d := a.p.createOMESession(ctx, "action_device_inventory_refresh Invoke")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	deviceIDs, err := getActionDeviceIDs(omeClient, config.DeviceIDs, config.DeviceServiceTags)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeviceAction, err.Error())
+		return
+	}
+
+	job, err := omeClient.RefreshDeviceInventory(deviceIDs, getActionJobOpts(config.JobName, config.JobDescription, defaultInventoryRefreshJobName))
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeviceAction, err.Error())
+		return
+	}
+
+	if err := trackActionJob(ctx, omeClient, job.ID, nil, config.Timeout, resp); err != nil {
+		resp.Diagnostics.AddError("Inventory refresh job could not complete.", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "action_device_inventory_refresh invoke: finished")
+}
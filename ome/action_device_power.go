@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultPowerJobName = "Power control"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &devicePowerAction{}
+	_ action.ActionWithConfigure = &devicePowerAction{}
+)
+
+// NewDevicePowerAction is a new action to change the power state of devices
+func NewDevicePowerAction() action.Action {
+	return &devicePowerAction{}
+}
+
+type devicePowerAction struct {
+	p *omeProvider
+}
+
+// Configure implements action.ActionWithConfigure
+func (a *devicePowerAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.p = configureAction(req)
+}
+
+// Metadata implements action.Action
+func (a *devicePowerAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "device_power"
+}
+
+// Schema implements action.Action
+func (a *devicePowerAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	attributes := deviceJobActionAttributes(defaultPowerJobName)
+	attributes["power_state"] = schema.StringAttribute{
+		MarkdownDescription: "Power operation applied on the devices." +
+			" Accepted values are `on`, `off`, `graceful_shutdown`, `power_cycle` and `reset`.",
+		Description: "Power operation applied on the devices." +
+			" Accepted values are 'on', 'off', 'graceful_shutdown', 'power_cycle' and 'reset'.",
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("on", "off", "graceful_shutdown", "power_cycle", "reset"),
+		},
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform action is used to change the power state of devices managed by OME." +
+			" The action creates a power control job on OME and waits for it to complete.",
+		Description: "This terraform action is used to change the power state of devices managed by OME." +
+			" The action creates a power control job on OME and waits for it to complete.",
+		Attributes: attributes,
+	}
+}
+
+// Invoke implements action.Action
+func (a *devicePowerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Trace(ctx, "action_device_power invoke: started")
+	var config models.DevicePowerAction
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	omeClient, d := a.p.createOMESession(ctx, "action_device_power Invoke")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	deviceIDs, err := getActionDeviceIDs(omeClient, config.DeviceIDs, config.DeviceServiceTags)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeviceAction, err.Error())
+		return
+	}
+
+	job, err := omeClient.PowerControlDevices(deviceIDs, config.PowerState.ValueString(),
+		getActionJobOpts(config.JobName, config.JobDescription, defaultPowerJobName))
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeviceAction, err.Error())
+		return
+	}
+
+	if err := trackActionJob(ctx, omeClient, job.ID, nil, config.Timeout, resp); err != nil {
+		resp.Diagnostics.AddError("Power control job could not complete.", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "action_device_power invoke: finished")
+}
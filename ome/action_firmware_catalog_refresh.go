@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &firmwareCatalogRefreshAction{}
+	_ action.ActionWithConfigure = &firmwareCatalogRefreshAction{}
+)
+
+// NewFirmwareCatalogRefreshAction is a new action to refresh firmware catalogs
+func NewFirmwareCatalogRefreshAction() action.Action {
+	return &firmwareCatalogRefreshAction{}
+}
+
+type firmwareCatalogRefreshAction struct {
+	p *omeProvider
+}
+
+// Configure implements action.ActionWithConfigure
+func (a *firmwareCatalogRefreshAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.p = configureAction(req)
+}
+
+// Metadata implements action.Action
+func (a *firmwareCatalogRefreshAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "firmware_catalog_refresh"
+}
+
+// Schema implements action.Action
+func (a *firmwareCatalogRefreshAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform action is used to refresh firmware catalogs on OME from their repository." +
+			" The action waits for the download task of every catalog to complete.",
+		Description: "This terraform action is used to refresh firmware catalogs on OME from their repository." +
+			" The action waits for the download task of every catalog to complete.",
+		Attributes: map[string]schema.Attribute{
+			"catalog_names": schema.ListAttribute{
+				MarkdownDescription: "Names of the firmware catalogs to refresh.",
+				Description:         "Names of the firmware catalogs to refresh.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"timeout": actionTimeoutAttribute(),
+		},
+	}
+}
+
+// Invoke implements action.Action
+func (a *firmwareCatalogRefreshAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Trace(ctx, "action_firmware_catalog_refresh invoke: started")
+	var config models.CatalogRefreshAction
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	omeClient, d := a.p.createOMESession(ctx, "action_firmware_catalog_refresh Invoke")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	catalogs := make([]models.CatalogsModel, 0, len(config.CatalogNames))
+	catalogIDs := make([]int64, 0, len(config.CatalogNames))
+	for _, name := range config.CatalogNames {
+		catalog, err := helper.GetCatalogFirmwareByName(omeClient, name)
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrCatalogRefresh, err.Error())
+			return
+		}
+		catalogs = append(catalogs, *catalog)
+		catalogIDs = append(catalogIDs, catalog.ID)
+	}
+
+	// the download tasks keep the status of their previous run till the refresh starts them again
+	lastRuns := map[int64]string{}
+	for _, catalog := range catalogs {
+		if catalog.TaskID == 0 {
+			continue
+		}
+		task, err := omeClient.GetJob(catalog.TaskID)
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrCatalogRefresh, err.Error())
+			return
+		}
+		lastRuns[catalog.TaskID] = task.LastRun
+	}
+
+	if err := omeClient.RefreshCatalogs(catalogIDs); err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCatalogRefresh, err.Error())
+		return
+	}
+
+	for _, catalog := range catalogs {
+		if catalog.TaskID == 0 {
+			continue
+		}
+		if resp.SendProgress != nil {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Refreshing catalog %s", catalog.Repository.Name)})
+		}
+		lastRun := lastRuns[catalog.TaskID]
+		if err := trackActionJob(ctx, omeClient, catalog.TaskID, &lastRun, config.Timeout, resp); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Catalog %s could not be refreshed.", catalog.Repository.Name), err.Error())
+			return
+		}
+	}
+	tflog.Trace(ctx, "action_firmware_catalog_refresh invoke: finished")
+}
@@ -0,0 +1,141 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-ome/clients"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// actionJobInterval - polling interval in seconds of the jobs run by actions
+	actionJobInterval int64 = 10
+)
+
+// configureAction returns the provider from the action configure request
+func configureAction(req action.ConfigureRequest) *omeProvider {
+	if req.ProviderData == nil {
+		return nil
+	}
+	return req.ProviderData.(*omeProvider)
+}
+
+// deviceJobActionAttributes returns the arguments shared by the actions running a job on devices
+func deviceJobActionAttributes(defaultJobName string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"device_ids": schema.ListAttribute{
+			MarkdownDescription: "List of ID of the devices on which the action is run." +
+				" Conflicts with `device_service_tags`.",
+			Description: "List of ID of the devices on which the action is run." +
+				" Conflicts with 'device_service_tags'.",
+			Optional:    true,
+			ElementType: types.Int64Type,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ExactlyOneOf(path.MatchRoot("device_service_tags")),
+			},
+		},
+		"device_service_tags": schema.ListAttribute{
+			MarkdownDescription: "List of service tags of the devices on which the action is run." +
+				" Conflicts with `device_ids`.",
+			Description: "List of service tags of the devices on which the action is run." +
+				" Conflicts with 'device_ids'.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+		},
+		"job_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the job created on OME to run the action." +
+				fmt.Sprintf(" Default value is `%s`.", defaultJobName),
+			Description: "Name of the job created on OME to run the action." +
+				fmt.Sprintf(" Default value is '%s'.", defaultJobName),
+			Optional: true,
+		},
+		"job_description": schema.StringAttribute{
+			MarkdownDescription: "Description of the job created on OME to run the action.",
+			Description:         "Description of the job created on OME to run the action.",
+			Optional:            true,
+		},
+		"timeout": actionTimeoutAttribute(),
+	}
+}
+
+// actionTimeoutAttribute returns the timeout argument of the actions
+func actionTimeoutAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: "Timeout, in minutes, for monitoring the job run by the action." +
+			fmt.Sprintf(" Default value is `%d`.", defaultJobTimeout),
+		Description: "Timeout, in minutes, for monitoring the job run by the action." +
+			fmt.Sprintf(" Default value is '%d'.", defaultJobTimeout),
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// getActionDeviceIDs resolves the devices targeted by an action
+func getActionDeviceIDs(omeClient *clients.Client, deviceIDs []int64, serviceTags []string) ([]int64, error) {
+	devices, err := omeClient.GetDevices(serviceTags, deviceIDs, nil)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(devices))
+	for _, device := range devices {
+		ids = append(ids, device.ID)
+	}
+	return ids, nil
+}
+
+// getActionJobOpts returns the options of a job run immediately by an action
+func getActionJobOpts(jobName, jobDescription types.String, defaultJobName string) clients.JobOpts {
+	name := defaultJobName
+	if jobName.ValueString() != "" {
+		name = jobName.ValueString()
+	}
+	return clients.JobOpts{
+		Name:        name,
+		Description: jobDescription.ValueString(),
+		RunNow:      true,
+	}
+}
+
+// trackActionJob monitors the job run by an action and streams its status changes as progress events.
+// When lastRun is set, the job is an existing job run again and its run newer than lastRun is monitored.
+func trackActionJob(ctx context.Context, omeClient *clients.Client, jobID int64, lastRun *string, timeout types.Int64, resp *action.InvokeResponse) error {
+	timeoutMinutes := defaultJobTimeout
+	if !timeout.IsNull() {
+		timeoutMinutes = timeout.ValueInt64()
+	}
+	maxRetries := timeoutMinutes * 60 / actionJobInterval
+	return omeClient.TrackJobRun(ctx, jobID, maxRetries, actionJobInterval, clients.JobTracking{
+		LastRun: lastRun,
+		Progress: func(message string) {
+			if resp.SendProgress != nil {
+				resp.SendProgress(action.InvokeProgressEvent{Message: message})
+			}
+		},
+	})
+}
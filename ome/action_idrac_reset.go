@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultIDRACResetJobName = "iDRAC reset"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &idracResetAction{}
+	_ action.ActionWithConfigure = &idracResetAction{}
+)
+
+// NewIDRACResetAction is a new action to reset the iDRAC of devices
+func NewIDRACResetAction() action.Action {
+	return &idracResetAction{}
+}
+
+type idracResetAction struct {
+	p *omeProvider
+}
+
+// Configure implements action.ActionWithConfigure
+func (a *idracResetAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.p = configureAction(req)
+}
+
+// Metadata implements action.Action
+func (a *idracResetAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "idrac_reset"
+}
+
+// Schema implements action.Action
+func (a *idracResetAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform action is used to reset the iDRAC of devices managed by OME. The action creates an iDRAC reset job on OME and waits for it to complete.",
+		Description:         "This terraform action is used to reset the iDRAC of devices managed by OME. The action creates an iDRAC reset job on OME and waits for it to complete.",
+		Attributes:          deviceJobActionAttributes(defaultIDRACResetJobName),
+	}
+}
+
+// Invoke implements action.Action
+func (a *idracResetAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Trace(ctx, "action_idrac_reset invoke: started")
+	var config models.DeviceJobAction
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	omeClient, d := a.p.createOMESession(ctx, "action_idrac_reset Invoke")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	deviceIDs, err := getActionDeviceIDs(omeClient, config.DeviceIDs, config.DeviceServiceTags)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeviceAction, err.Error())
+		return
+	}
+
+	job, err := omeClient.ResetIDRAC(deviceIDs, getActionJobOpts(config.JobName, config.JobDescription, defaultIDRACResetJobName))
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeviceAction, err.Error())
+		return
+	}
+
+	if err := trackActionJob(ctx, omeClient, job.ID, nil, config.Timeout, resp); err != nil {
+		resp.Diagnostics.AddError("iDRAC reset job could not complete.", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "action_idrac_reset invoke: finished")
+}
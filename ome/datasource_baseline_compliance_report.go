@@ -15,12 +15,17 @@ package ome
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"terraform-provider-ome/clients"
 	"terraform-provider-ome/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
@@ -73,6 +78,52 @@ func (*configurationReportDataSource) Schema(ctx context.Context, req datasource
 				Computed:            true,
 				Optional:            true,
 			},
+			"non_compliant_only": schema.BoolAttribute{
+				MarkdownDescription: "Report only the non compliant devices, and only the non compliant components and attributes of those devices." +
+					" Default value is `false`.",
+				Description: "Report only the non compliant devices, and only the non compliant components and attributes of those devices." +
+					" Default value is 'false'.",
+				Optional: true,
+			},
+			"components": schema.SetAttribute{
+				MarkdownDescription: "Report only the attributes of these components, for example `BIOS`, `iDRAC` or `NIC`." +
+					" Only applicable when `fetch_attributes` is `true`.",
+				Description: "Report only the attributes of these components, for example 'BIOS', 'iDRAC' or 'NIC'." +
+					" Only applicable when 'fetch_attributes' is 'true'.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"device_servicetags": schema.SetAttribute{
+				MarkdownDescription: "Report only the devices with these service tags.",
+				Description:         "Report only the devices with these service tags.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"device_ids": schema.SetAttribute{
+				MarkdownDescription: "Report only the devices with these IDs.",
+				Description:         "Report only the devices with these IDs.",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"refresh_compliance": schema.BoolAttribute{
+				MarkdownDescription: "Run the compliance task of the baseline and wait for it to complete before reading the report," +
+					" so that the report reflects the current state of the devices." +
+					" Default value is `false`.",
+				Description: "Run the compliance task of the baseline and wait for it to complete before reading the report," +
+					" so that the report reflects the current state of the devices." +
+					" Default value is 'false'.",
+				Optional: true,
+			},
+			"refresh_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout, in minutes, for the compliance task run by `refresh_compliance`." +
+					fmt.Sprintf(" Default value is `%d`.", defaultJobTimeout),
+				Description: "Timeout, in minutes, for the compliance task run by 'refresh_compliance'." +
+					fmt.Sprintf(" Default value is '%d'.", defaultJobTimeout),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"compliance_report_device": schema.ListNestedAttribute{
 				MarkdownDescription: "Device compliance report.",
 				Description:         "Device compliance report.",
@@ -119,6 +170,74 @@ func (*configurationReportDataSource) Schema(ctx context.Context, req datasource
 							Description:         "Device compliance details.",
 							Computed:            true,
 						},
+						"compliance_components": schema.ListNestedAttribute{
+							MarkdownDescription: "Attribute compliance report of the device components, fetched when `fetch_attributes` is `true`.",
+							Description:         "Attribute compliance report of the device components, fetched when 'fetch_attributes' is 'true'.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"component": schema.StringAttribute{
+										MarkdownDescription: "Name of the component.",
+										Description:         "Name of the component.",
+										Computed:            true,
+									},
+									"compliance_status": schema.StringAttribute{
+										MarkdownDescription: "Compliance status of the component.",
+										Description:         "Compliance status of the component.",
+										Computed:            true,
+									},
+									"compliance_reason": schema.StringAttribute{
+										MarkdownDescription: "Reason of the compliance status.",
+										Description:         "Reason of the compliance status.",
+										Computed:            true,
+									},
+									"attributes": schema.ListNestedAttribute{
+										MarkdownDescription: "Compliance of the attributes of the component.",
+										Description:         "Compliance of the attributes of the component.",
+										Computed:            true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"group": schema.StringAttribute{
+													MarkdownDescription: "Attribute group within the component.",
+													Description:         "Attribute group within the component.",
+													Computed:            true,
+												},
+												"attribute_id": schema.Int64Attribute{
+													MarkdownDescription: "Attribute ID.",
+													Description:         "Attribute ID.",
+													Computed:            true,
+												},
+												"attribute_name": schema.StringAttribute{
+													MarkdownDescription: "Attribute name.",
+													Description:         "Attribute name.",
+													Computed:            true,
+												},
+												"expected_value": schema.StringAttribute{
+													MarkdownDescription: "Value of the attribute in the baseline template.",
+													Description:         "Value of the attribute in the baseline template.",
+													Computed:            true,
+												},
+												"current_value": schema.StringAttribute{
+													MarkdownDescription: "Value of the attribute on the device.",
+													Description:         "Value of the attribute on the device.",
+													Computed:            true,
+												},
+												"compliance_status": schema.StringAttribute{
+													MarkdownDescription: "Compliance status of the attribute.",
+													Description:         "Compliance status of the attribute.",
+													Computed:            true,
+												},
+												"compliance_reason": schema.StringAttribute{
+													MarkdownDescription: "Reason of the compliance status.",
+													Description:         "Reason of the compliance status.",
+													Computed:            true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -143,6 +262,22 @@ func (g *configurationReportDataSource) Read(ctx context.Context, req datasource
 	defer omeClient.RemoveSession()
 
 	var state models.ConfigurationReports
+	state.NonCompliantOnly = config.NonCompliantOnly
+	state.Components = config.Components
+	state.DeviceServiceTags = config.DeviceServiceTags
+	state.DeviceIDs = config.DeviceIDs
+	state.RefreshCompliance = config.RefreshCompliance
+	state.RefreshTimeout = config.RefreshTimeout
+
+	var componentNames, serviceTags []string
+	var deviceIDs []int64
+	resp.Diagnostics.Append(config.Components.ElementsAs(ctx, &componentNames, true)...)
+	resp.Diagnostics.Append(config.DeviceServiceTags.ElementsAs(ctx, &serviceTags, true)...)
+	resp.Diagnostics.Append(config.DeviceIDs.ElementsAs(ctx, &deviceIDs, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	nonCompliantOnly := config.NonCompliantOnly.ValueBool()
 
 	baseline, err := omeClient.GetBaselineByName(config.BaseLineName.ValueString())
 	if err != nil {
@@ -151,6 +286,15 @@ func (g *configurationReportDataSource) Read(ctx context.Context, req datasource
 		)
 		return
 	}
+	if config.RefreshCompliance.ValueBool() {
+		baseline, err = refreshBaselineCompliance(ctx, omeClient, baseline, config.RefreshTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				clients.ErrGnrConfigurationReport, err.Error(),
+			)
+			return
+		}
+	}
 	if baseline.ConfigComplianceSummary.ComplianceStatus != "NotInventored" {
 
 		baselineID := baseline.ID
@@ -166,6 +310,9 @@ func (g *configurationReportDataSource) Read(ctx context.Context, req datasource
 		state.ID = config.BaseLineName
 
 		for _, cr := range complianceReports {
+			if !complianceReportSelected(cr, serviceTags, deviceIDs, nonCompliantOnly) {
+				continue
+			}
 			compStatus := "Compliant"
 			if cr.ComplianceStatus != 1 {
 				compStatus = "Non Compliant"
@@ -188,6 +335,14 @@ func (g *configurationReportDataSource) Read(ctx context.Context, req datasource
 					return
 				}
 				crd.DeviceComplianceDetails = types.StringValue(attrResp)
+				detail, err := clients.ParseDeviceComplianceDetail(attrResp)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						clients.ErrGnrConfigurationReport, err.Error(),
+					)
+					return
+				}
+				crd.ComplianceComponents = newComplianceReportComponents(clients.ComplianceComponents(detail, componentNames, nonCompliantOnly))
 			}
 			state.ComplianceReportDevice = append(state.ComplianceReportDevice, crd)
 		}
@@ -195,3 +350,66 @@ func (g *configurationReportDataSource) Read(ctx context.Context, req datasource
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// refreshBaselineCompliance runs the compliance task of the baseline, waits for it and returns the refreshed baseline
+func refreshBaselineCompliance(ctx context.Context, omeClient *clients.Client, baseline models.OmeBaseline, timeout types.Int64) (models.OmeBaseline, error) {
+	timeoutMinutes := defaultJobTimeout
+	if !timeout.IsNull() {
+		timeoutMinutes = timeout.ValueInt64()
+	}
+	tflog.Debug(ctx, "datasource_configuration_report: refreshing the compliance", map[string]interface{}{
+		"baseline": baseline.Name,
+		"taskid":   baseline.TaskID,
+	})
+	maxRetries := timeoutMinutes * 60 / actionJobInterval
+	if err := omeClient.RerunJob(ctx, baseline.TaskID, maxRetries, actionJobInterval, nil); err != nil {
+		return baseline, fmt.Errorf("compliance task of baseline %s could not complete: %w", baseline.Name, err)
+	}
+	return omeClient.GetBaselineByID(baseline.ID)
+}
+
+// complianceReportSelected returns whether the device report passes the device and compliance filters
+func complianceReportSelected(cr models.OMEComplianceReports, serviceTags []string, deviceIDs []int64, nonCompliantOnly bool) bool {
+	if nonCompliantOnly && cr.ComplianceStatus == clients.ComplianceStatusCompliant {
+		return false
+	}
+	if len(serviceTags) == 0 && len(deviceIDs) == 0 {
+		return true
+	}
+	for _, serviceTag := range serviceTags {
+		if strings.EqualFold(serviceTag, cr.ServiceTag) {
+			return true
+		}
+	}
+	for _, deviceID := range deviceIDs {
+		if deviceID == cr.ID {
+			return true
+		}
+	}
+	return false
+}
+
+func newComplianceReportComponents(components []models.OMEComplianceComponent) []models.ComplianceReportComponent {
+	reportComponents := []models.ComplianceReportComponent{}
+	for _, component := range components {
+		reportComponent := models.ComplianceReportComponent{
+			Component:        types.StringValue(component.Name),
+			ComplianceStatus: types.StringValue(clients.ComplianceStatusName(component.ComplianceStatus)),
+			ComplianceReason: types.StringValue(component.ComplianceReason),
+			Attributes:       []models.ComplianceReportAttribute{},
+		}
+		for _, attribute := range component.Attributes {
+			reportComponent.Attributes = append(reportComponent.Attributes, models.ComplianceReportAttribute{
+				Group:            types.StringValue(attribute.Group),
+				AttributeID:      types.Int64Value(attribute.AttributeID),
+				AttributeName:    types.StringValue(attribute.DisplayName),
+				ExpectedValue:    types.StringValue(attribute.ExpectedValue),
+				CurrentValue:     types.StringValue(attribute.Value),
+				ComplianceStatus: types.StringValue(clients.ComplianceStatusName(attribute.ComplianceStatus)),
+				ComplianceReason: types.StringValue(attribute.ComplianceReason),
+			})
+		}
+		reportComponents = append(reportComponents, reportComponent)
+	}
+	return reportComponents
+}
@@ -45,6 +45,13 @@ func TestDataSource_ReadConfigurationReport(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.ome_configuration_report_info.cr", "compliance_report_device.#", "2")),
 			},
+			{
+				Config: testConfiguratiponReportDSFiltered + temps.templateSvcTag1Full,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_configuration_report_info.cr", "compliance_report_device.#", "1"),
+					resource.TestCheckResourceAttr("data.ome_configuration_report_info.cr", "compliance_report_device.0.device_servicetag", DeviceSvcTag1),
+					resource.TestCheckResourceAttrSet("data.ome_configuration_report_info.cr", "compliance_report_device.0.compliance_components.#")),
+			},
 		},
 	})
 }
@@ -83,3 +90,25 @@ var testConfiguratiponReportDS = testProvider + `
 		]
 	}
 `
+
+var testConfiguratiponReportDSFiltered = testProvider + `
+	resource "ome_configuration_baseline" "create_baseline" {
+		baseline_name = "` + BaselineName + `"
+		ref_template_name = "` + TestRefTemplateName + `"
+		device_servicetags = ["` + DeviceSvcTag1 + `","` + DeviceSvcTag2 + `"]
+		description = "baseline description"
+		depends_on = ["ome_template.terraform-acceptance-test-1"]
+	}
+
+	data "ome_configuration_report_info" "cr" {
+		id = "0"
+		baseline_name = "` + BaselineName + `"
+		fetch_attributes = true
+		device_servicetags = ["` + DeviceSvcTag1 + `"]
+		components = ["BIOS", "iDRAC"]
+		refresh_compliance = true
+		depends_on =[
+			"ome_configuration_baseline.create_baseline"
+		]
+	}
+`
@@ -0,0 +1,285 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &catalogContentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &catalogContentsDataSource{}
+)
+
+// NewCatalogContentsDataSource is a new datasource to read the bundles and components of a local Dell update catalog
+func NewCatalogContentsDataSource() datasource.DataSource {
+	return &catalogContentsDataSource{}
+}
+
+type catalogContentsDataSource struct {
+	p *omeProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (d *catalogContentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*catalogContentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "catalog_contents"
+}
+
+func catalogContentsAttributeSchema(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Description:         description,
+		Computed:            true,
+	}
+}
+
+func catalogContentsListSchema(description string) schema.ListAttribute {
+	return schema.ListAttribute{
+		MarkdownDescription: description,
+		Description:         description,
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+}
+
+// Schema implements datasource.DataSource
+func (d *catalogContentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform DataSource is used to list the bundles and components of a Dell update catalog, such as `Catalog.xml` or `Catalog.xml.gz`," +
+			" before it is imported into OME. The catalog is read from the local filesystem, no connection to OME is made.",
+		Description: "This Terraform DataSource is used to list the bundles and components of a Dell update catalog, such as 'Catalog.xml' or 'Catalog.xml.gz'," +
+			" before it is imported into OME. The catalog is read from the local filesystem, no connection to OME is made.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the datasource, the release ID of the catalog.",
+				Description:         "ID of the datasource, the release ID of the catalog.",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Local path or `file://` url of the catalog. Gzip compressed catalogs are supported.",
+				Description:         "Local path or 'file://' url of the catalog. Gzip compressed catalogs are supported.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"system_ids": schema.SetAttribute{
+				MarkdownDescription: "Lists the bundles and components for these system IDs only, such as `0A6B`." +
+					" When used with `models`, the entries matching either are listed.",
+				Description: "Lists the bundles and components for these system IDs only, such as '0A6B'." +
+					" When used with 'models', the entries matching either are listed.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"models": schema.SetAttribute{
+				MarkdownDescription: "Lists the bundles and components for these models only, such as `R650`." +
+					" When used with `system_ids`, the entries matching either are listed.",
+				Description: "Lists the bundles and components for these models only, such as 'R650'." +
+					" When used with 'system_ids', the entries matching either are listed.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"version":       catalogContentsAttributeSchema("Version of the catalog."),
+			"release_id":    catalogContentsAttributeSchema("Release ID of the catalog."),
+			"date_time":     catalogContentsAttributeSchema("Date and time the catalog was published."),
+			"base_location": catalogContentsAttributeSchema("Base location the paths of the catalog are relative to."),
+			"bundles": schema.ListNestedAttribute{
+				MarkdownDescription: "Bundles of the catalog.",
+				Description:         "Bundles of the catalog.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"release_id":   catalogContentsAttributeSchema("Release ID of the bundle."),
+						"name":         catalogContentsAttributeSchema("Name of the bundle."),
+						"bundle_type":  catalogContentsAttributeSchema("Type of the bundle, such as `BTW64`."),
+						"version":      catalogContentsAttributeSchema("Version of the bundle."),
+						"release_date": catalogContentsAttributeSchema("Release date of the bundle."),
+						"path":         catalogContentsAttributeSchema("Path of the bundle."),
+						"system_ids":   catalogContentsListSchema("System IDs the bundle targets."),
+						"models":       catalogContentsListSchema("Models the bundle targets."),
+						"packages":     catalogContentsListSchema("Paths of the component packages of the bundle."),
+					},
+				},
+			},
+			"components": schema.ListNestedAttribute{
+				MarkdownDescription: "Components of the catalog.",
+				Description:         "Components of the catalog.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"package_id":     catalogContentsAttributeSchema("Package ID of the component."),
+						"name":           catalogContentsAttributeSchema("Name of the component."),
+						"component_type": catalogContentsAttributeSchema("Type of the component, such as `BIOS`, `FRMW`, `DRVR` or `APAC`."),
+						"category":       catalogContentsAttributeSchema("Category of the component."),
+						"version":        catalogContentsAttributeSchema("Version of the component."),
+						"dell_version":   catalogContentsAttributeSchema("Dell version of the component."),
+						"criticality":    catalogContentsAttributeSchema("Criticality of the component, `Optional`, `Recommended` or `Urgent`."),
+						"release_date":   catalogContentsAttributeSchema("Release date of the component."),
+						"reboot_required": schema.BoolAttribute{
+							MarkdownDescription: "Whether the update of the component requires a reboot.",
+							Description:         "Whether the update of the component requires a reboot.",
+							Computed:            true,
+						},
+						"path":       catalogContentsAttributeSchema("Path of the component package."),
+						"system_ids": catalogContentsListSchema("System IDs the component supports."),
+						"models":     catalogContentsListSchema("Models the component supports."),
+						"dependencies": schema.ListNestedAttribute{
+							MarkdownDescription: "Packages to be installed before the component.",
+							Description:         "Packages to be installed before the component.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"package_id": catalogContentsAttributeSchema("Package ID of the dependency."),
+									"name":       catalogContentsAttributeSchema("Name of the dependency."),
+									"version":    catalogContentsAttributeSchema("Minimum version of the dependency."),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (d *catalogContentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "datasource_catalog_contents read: started")
+	var state models.CatalogContents
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var systemIDs []string
+	var modelNames []string
+	resp.Diagnostics.Append(state.SystemIDs.ElementsAs(ctx, &systemIDs, true)...)
+	resp.Diagnostics.Append(state.Models.ElementsAs(ctx, &modelNames, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	manifest, err := clients.ReadCatalogFile(state.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrCatalogContents, err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(manifest.ReleaseID)
+	state.Version = types.StringValue(manifest.Version)
+	state.ReleaseID = types.StringValue(manifest.ReleaseID)
+	state.DateTime = types.StringValue(manifest.DateTime)
+	state.BaseLocation = types.StringValue(manifest.BaseLocation)
+	state.Bundles = []models.CatalogBundle{}
+	for _, bundle := range clients.CatalogBundles(manifest, systemIDs, modelNames) {
+		catalogBundle, diags := newCatalogBundle(ctx, bundle)
+		resp.Diagnostics.Append(diags...)
+		state.Bundles = append(state.Bundles, catalogBundle)
+	}
+	state.Components = []models.CatalogComponent{}
+	for _, component := range clients.CatalogComponents(manifest, systemIDs, modelNames) {
+		catalogComponent, diags := newCatalogComponent(ctx, component)
+		resp.Diagnostics.Append(diags...)
+		state.Components = append(state.Components, catalogComponent)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "datasource_catalog_contents read: parsed catalog", map[string]interface{}{
+		"bundles":    len(state.Bundles),
+		"components": len(state.Components),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "datasource_catalog_contents read: finished")
+}
+
+// newCatalogBundle converts a bundle of the catalog to its tfsdk model
+func newCatalogBundle(ctx context.Context, bundle models.CatalogBundleInfo) (models.CatalogBundle, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	systemIDs, d := types.ListValueFrom(ctx, types.StringType, bundle.SystemIDs)
+	diags.Append(d...)
+	bundleModels, d := types.ListValueFrom(ctx, types.StringType, bundle.Models)
+	diags.Append(d...)
+	packages, d := types.ListValueFrom(ctx, types.StringType, bundle.Packages)
+	diags.Append(d...)
+	return models.CatalogBundle{
+		ReleaseID:   types.StringValue(bundle.ReleaseID),
+		Name:        types.StringValue(bundle.Name),
+		BundleType:  types.StringValue(bundle.BundleType),
+		Version:     types.StringValue(bundle.Version),
+		ReleaseDate: types.StringValue(bundle.ReleaseDate),
+		Path:        types.StringValue(bundle.Path),
+		SystemIDs:   systemIDs,
+		Models:      bundleModels,
+		Packages:    packages,
+	}, diags
+}
+
+// newCatalogComponent converts a component of the catalog to its tfsdk model
+func newCatalogComponent(ctx context.Context, component models.CatalogComponentInfo) (models.CatalogComponent, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	systemIDs, d := types.ListValueFrom(ctx, types.StringType, component.SystemIDs)
+	diags.Append(d...)
+	componentModels, d := types.ListValueFrom(ctx, types.StringType, component.Models)
+	diags.Append(d...)
+	dependencies := []models.CatalogDependency{}
+	for _, dependency := range component.Dependencies {
+		dependencies = append(dependencies, models.CatalogDependency{
+			PackageID: types.StringValue(dependency.PackageID),
+			Name:      types.StringValue(dependency.Name),
+			Version:   types.StringValue(dependency.Version),
+		})
+	}
+	return models.CatalogComponent{
+		PackageID:      types.StringValue(component.PackageID),
+		Name:           types.StringValue(component.Name),
+		ComponentType:  types.StringValue(component.ComponentType),
+		Category:       types.StringValue(component.Category),
+		Version:        types.StringValue(component.Version),
+		DellVersion:    types.StringValue(component.DellVersion),
+		Criticality:    types.StringValue(component.Criticality),
+		ReleaseDate:    types.StringValue(component.ReleaseDate),
+		RebootRequired: types.BoolValue(component.RebootRequired),
+		Path:           types.StringValue(component.Path),
+		SystemIDs:      systemIDs,
+		Models:         componentModels,
+		Dependencies:   dependencies,
+	}, diags
+}
@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSource_CatalogContents(t *testing.T) {
+	if skipTest() {
+		t.Skip(SkipTestMsg)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCatalogContents,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_catalog_contents.catalog", "version", "24.03.00"),
+					resource.TestCheckResourceAttr("data.ome_catalog_contents.catalog", "bundles.#", "1"),
+					resource.TestCheckResourceAttr("data.ome_catalog_contents.catalog", "bundles.0.models.0", "R650"),
+					resource.TestCheckResourceAttr("data.ome_catalog_contents.catalog", "components.#", "1"),
+					resource.TestCheckResourceAttr("data.ome_catalog_contents.catalog", "components.0.package_id", "0DKF6"),
+					resource.TestCheckResourceAttr("data.ome_catalog_contents.catalog", "components.0.criticality", "Recommended"),
+					resource.TestCheckResourceAttr("data.ome_catalog_contents.catalog", "components.0.reboot_required", "false"),
+				),
+			},
+			{
+				Config:      testCatalogContentsRemote,
+				ExpectError: regexp.MustCompile(".*only local paths and file:// urls are supported.*"),
+			},
+		},
+	})
+}
+
+var testCatalogContents = testProvider + `
+	data "ome_catalog_contents" "catalog" {
+		path       = "../clients/json_data/catalogContents.xml"
+		system_ids = ["0A6B"]
+	}
+`
+
+var testCatalogContentsRemote = testProvider + `
+	data "ome_catalog_contents" "catalog" {
+		path = "https://downloads.dell.com/catalog/Catalog.xml.gz"
+	}
+`
@@ -0,0 +1,403 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// PrecheckRetryCount is the default number of polls of the pre-check job
+	PrecheckRetryCount = 20
+	// PrecheckSleepInterval is the default interval in seconds between the polls of the pre-check job
+	PrecheckSleepInterval = 30
+)
+
+var (
+	_ datasource.DataSource              = &deploymentPrecheckDataSource{}
+	_ datasource.DataSourceWithConfigure = &deploymentPrecheckDataSource{}
+)
+
+// NewDeploymentPrecheckDataSource is a new datasource to pre-check the deployment of a template
+func NewDeploymentPrecheckDataSource() datasource.DataSource {
+	return &deploymentPrecheckDataSource{}
+}
+
+type deploymentPrecheckDataSource struct {
+	p *omeProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (d *deploymentPrecheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*deploymentPrecheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "deployment_precheck"
+}
+
+// Schema implements datasource.DataSource
+func (d *deploymentPrecheckDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform DataSource is used to run a pre-check only deployment of a template on the target devices and report the result of every device." +
+			" The pre-check runs on every read of the datasource and does not change the configuration of the devices.",
+		Description: "This Terraform DataSource is used to run a pre-check only deployment of a template on the target devices and report the result of every device." +
+			" The pre-check runs on every read of the datasource and does not change the configuration of the devices.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the pre-check, the ID of its job.",
+				Description:         "ID of the pre-check, the ID of its job.",
+				Computed:            true,
+			},
+			"template_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the template." +
+					" If a template with this ID is found, `template_name` will be ignored.",
+				Description: "ID of the template." +
+					" If a template with this ID is found, 'template_name' will be ignored.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeastOneOf(path.MatchRoot("template_name")),
+				},
+			},
+			"template_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the template.",
+				Description:         "Name of the template.",
+				Optional:            true,
+			},
+			"device_ids": schema.SetAttribute{
+				MarkdownDescription: "List of the device id(s)." +
+					" Conflicts with `device_servicetags`.",
+				Description: "List of the device id(s)." +
+					" Conflicts with 'device_servicetags'.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ExactlyOneOf(path.MatchRoot("device_servicetags")),
+				},
+			},
+			"device_servicetags": schema.SetAttribute{
+				MarkdownDescription: "List of the device servicetags." +
+					" Conflicts with `device_ids`.",
+				Description: "List of the device servicetags." +
+					" Conflicts with 'device_ids'.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"options_strict_checking_vlan": schema.BoolAttribute{
+				MarkdownDescription: "Checks the strict association of vlan.",
+				Description:         "Checks the strict association of vlan.",
+				Optional:            true,
+			},
+			"job_retry_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of times the pre-check job has to be polled to get its final status." +
+					fmt.Sprintf(" Default value is `%d`.", PrecheckRetryCount),
+				Description: "Number of times the pre-check job has to be polled to get its final status." +
+					fmt.Sprintf(" Default value is '%d'.", PrecheckRetryCount),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"sleep_interval": schema.Int64Attribute{
+				MarkdownDescription: "Sleep time interval in seconds between the polls of the pre-check job." +
+					fmt.Sprintf(" Default value is `%d`.", PrecheckSleepInterval),
+				Description: "Sleep time interval in seconds between the polls of the pre-check job." +
+					fmt.Sprintf(" Default value is '%d'.", PrecheckSleepInterval),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"job_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the pre-check job.",
+				Description:         "ID of the pre-check job.",
+				Computed:            true,
+			},
+			"passed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the pre-check passed on every target device.",
+				Description:         "Whether the pre-check passed on every target device.",
+				Computed:            true,
+			},
+			"reboot_required": schema.BoolAttribute{
+				MarkdownDescription: "Whether the deployment requires a reboot of any target device.",
+				Description:         "Whether the deployment requires a reboot of any target device.",
+				Computed:            true,
+			},
+			"devices": schema.ListNestedAttribute{
+				MarkdownDescription: "Pre-check result of every target device.",
+				Description:         "Pre-check result of every target device.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"device_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the device.",
+							Description:         "ID of the device.",
+							Computed:            true,
+						},
+						"service_tag": schema.StringAttribute{
+							MarkdownDescription: "Service tag of the device.",
+							Description:         "Service tag of the device.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the pre-check on the device.",
+							Description:         "Status of the pre-check on the device.",
+							Computed:            true,
+						},
+						"passed": schema.BoolAttribute{
+							MarkdownDescription: "Whether the pre-check passed on the device.",
+							Description:         "Whether the pre-check passed on the device.",
+							Computed:            true,
+						},
+						"reboot_required": schema.BoolAttribute{
+							MarkdownDescription: "Whether the deployment requires a reboot of the device.",
+							Description:         "Whether the deployment requires a reboot of the device.",
+							Computed:            true,
+						},
+						"messages": schema.ListAttribute{
+							MarkdownDescription: "Messages reported by the pre-check of the device.",
+							Description:         "Messages reported by the pre-check of the device.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"attribute_messages": schema.ListNestedAttribute{
+							MarkdownDescription: "Messages reported about the attributes of the template.",
+							Description:         "Messages reported about the attributes of the template.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"attribute": schema.StringAttribute{
+										MarkdownDescription: "Attribute as the FQDD of its component and its name separated by `#`.",
+										Description:         "Attribute as the FQDD of its component and its name separated by '#'.",
+										Computed:            true,
+									},
+									"message": schema.StringAttribute{
+										MarkdownDescription: "Message about the attribute.",
+										Description:         "Message about the attribute.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"vlan_conflicts": schema.ListAttribute{
+							MarkdownDescription: "Messages reporting VLANs of the template that conflict with the network of the device.",
+							Description:         "Messages reporting VLANs of the template that conflict with the network of the device.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (d *deploymentPrecheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "datasource_deployment_precheck read: started")
+	var state models.DeploymentPrecheck
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var serviceTags []string
+	var devIDs []int64
+	resp.Diagnostics.Append(state.DeviceServicetags.ElementsAs(ctx, &serviceTags, true)...)
+	resp.Diagnostics.Append(state.DeviceIDs.ElementsAs(ctx, &devIDs, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	omeClient, diags := d.p.createOMESession(ctx, "datasource_deployment_precheck Read")
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	omeTemplate, err := omeClient.GetTemplateByIDOrName(state.TemplateID.ValueInt64(), state.TemplateName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeploymentPrecheck, err.Error())
+		return
+	}
+	devices, err := omeClient.GetDevices(serviceTags, devIDs, []string{})
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeploymentPrecheck, err.Error())
+		return
+	}
+	_, deviceIDs, _ := omeClient.GetUniqueDevicesIdsAndServiceTags(devices)
+
+	// profiles created by the pre-check are removed so that they are not taken for a deployment
+	existingProfiles, err := omeClient.GetServerProfileInfoByTemplateName(omeTemplate.Name)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeploymentPrecheck, err.Error())
+		return
+	}
+
+	jobID, err := omeClient.CreateDeployment(models.OMETemplateDeployRequest{
+		ID:        omeTemplate.ID,
+		TargetIDS: deviceIDs,
+		Options: models.OMEOptions{
+			TimeToWaitBeforeShutdown: 300,
+			EndHostPowerState:        1,
+			PrecheckOnly:             true,
+			StrictCheckingVLAN:       state.OptionsStrictCheckingVlan.ValueBool(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeploymentPrecheck, err.Error())
+		return
+	}
+	defer removePrecheckProfiles(ctx, omeClient, omeTemplate.Name, jobID, deviceIDs, existingProfiles)
+
+	retryCount, sleepInterval := int64(PrecheckRetryCount), int64(PrecheckSleepInterval)
+	if !state.JobRetryCount.IsNull() {
+		retryCount = state.JobRetryCount.ValueInt64()
+	}
+	if !state.SleepInterval.IsNull() {
+		sleepInterval = state.SleepInterval.ValueInt64()
+	}
+	tflog.Debug(ctx, "datasource_deployment_precheck read: tracking the pre-check job", map[string]interface{}{
+		"jobID": jobID,
+	})
+	// a failed pre-check is reported through the execution details of the devices
+	_, message := omeClient.TrackJob(jobID, retryCount, sleepInterval)
+
+	details, err := helper.GetJobExecutionDetails(ctx, omeClient, jobID)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeploymentPrecheck, err.Error())
+		return
+	}
+	if len(details) == 0 {
+		resp.Diagnostics.AddError(clients.ErrGnrDeploymentPrecheck, fmt.Sprintf("the pre-check job %d did not report any device: %s", jobID, message))
+		return
+	}
+
+	serviceTagsByID := map[int64]string{}
+	for _, device := range devices {
+		serviceTagsByID[device.ID] = device.DeviceServiceTag
+	}
+	state.ID = types.StringValue(strconv.FormatInt(jobID, 10))
+	state.JobID = types.Int64Value(jobID)
+	state.Passed = types.BoolValue(true)
+	state.RebootRequired = types.BoolValue(false)
+	state.Devices = []models.DeploymentPrecheckDevice{}
+	for _, result := range clients.ParseDeploymentPrecheck(details) {
+		device := newDeploymentPrecheckDevice(result, serviceTagsByID)
+		if !result.Passed {
+			state.Passed = types.BoolValue(false)
+		}
+		if result.RebootRequired {
+			state.RebootRequired = types.BoolValue(true)
+		}
+		state.Devices = append(state.Devices, device)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "datasource_deployment_precheck read: finished")
+}
+
+// newDeploymentPrecheckDevice converts the pre-check result of a device to its tfsdk model
+func newDeploymentPrecheckDevice(result models.OMEDeploymentPrecheckResult, serviceTags map[int64]string) models.DeploymentPrecheckDevice {
+	serviceTag, ok := serviceTags[result.DeviceID]
+	if !ok {
+		serviceTag = result.Key
+	}
+	device := models.DeploymentPrecheckDevice{
+		DeviceID:          types.Int64Value(result.DeviceID),
+		ServiceTag:        types.StringValue(serviceTag),
+		Status:            types.StringValue(result.Status),
+		Passed:            types.BoolValue(result.Passed),
+		RebootRequired:    types.BoolValue(result.RebootRequired),
+		Messages:          []types.String{},
+		AttributeMessages: []models.DeploymentPrecheckAttributeMessage{},
+		VlanConflicts:     []types.String{},
+	}
+	for _, message := range result.Messages {
+		device.Messages = append(device.Messages, types.StringValue(message))
+	}
+	for _, attributeMessage := range result.AttributeMessages {
+		device.AttributeMessages = append(device.AttributeMessages, models.DeploymentPrecheckAttributeMessage{
+			Attribute: types.StringValue(attributeMessage.Attribute),
+			Message:   types.StringValue(attributeMessage.Message),
+		})
+	}
+	for _, conflict := range result.VlanConflicts {
+		device.VlanConflicts = append(device.VlanConflicts, types.StringValue(conflict))
+	}
+	return device
+}
+
+// removePrecheckProfiles deletes the server profiles created by the pre-check job
+func removePrecheckProfiles(ctx context.Context, omeClient *clients.Client, templateName string, jobID int64, deviceIDs []int64, existingProfiles models.OMEServerProfiles) {
+	serverProfiles, err := omeClient.GetServerProfileInfoByTemplateName(templateName)
+	if err != nil {
+		tflog.Warn(ctx, "datasource_deployment_precheck: unable to get the server profiles "+err.Error())
+		return
+	}
+	profileIDs := getPrecheckProfileIDs(serverProfiles, existingProfiles, jobID, deviceIDs)
+	if len(profileIDs) == 0 {
+		return
+	}
+	if err := deleteProfiles(ctx, omeClient, profileIDs); err != nil {
+		tflog.Warn(ctx, "datasource_deployment_precheck: unable to delete the server profiles "+err.Error())
+	}
+}
+
+// getPrecheckProfileIDs returns the profiles which did not exist before the pre-check and target one of its devices,
+// profiles of other deployments of the template running at the same time are kept
+func getPrecheckProfileIDs(serverProfiles, existingProfiles models.OMEServerProfiles, jobID int64, deviceIDs []int64) []int64 {
+	existing := map[int64]bool{}
+	for _, serverProfile := range existingProfiles.Value {
+		existing[serverProfile.ID] = true
+	}
+	targets := map[int64]bool{}
+	for _, deviceID := range deviceIDs {
+		targets[deviceID] = true
+	}
+	profileIDs := []int64{}
+	for _, serverProfile := range serverProfiles.Value {
+		if existing[serverProfile.ID] || !targets[serverProfile.TargetID] {
+			continue
+		}
+		if serverProfile.DeploymentTaskID != 0 && serverProfile.DeploymentTaskID != jobID {
+			continue
+		}
+		profileIDs = append(profileIDs, serverProfile.ID)
+	}
+	return profileIDs
+}
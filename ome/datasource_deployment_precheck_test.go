@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"regexp"
+	"terraform-provider-ome/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSource_DeploymentPrecheck(t *testing.T) {
+	temps := initTemplates(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: justProvider + temps.templateSvcTag1Full,
+			},
+			{
+				Config: testDeploymentPrecheck + temps.templateSvcTag1Full,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ome_deployment_precheck.precheck", "job_id"),
+					resource.TestCheckResourceAttrSet("data.ome_deployment_precheck.precheck", "passed"),
+					resource.TestCheckResourceAttr("data.ome_deployment_precheck.precheck", "devices.#", "1"),
+					resource.TestCheckResourceAttr("data.ome_deployment_precheck.precheck", "devices.0.service_tag", DeviceSvcTag1),
+				),
+			},
+			{
+				Config:      testDeploymentPrecheckInvalidTemplate,
+				ExpectError: regexp.MustCompile(".*error running the deployment pre-check.*"),
+			},
+			{
+				Config:      testDeploymentPrecheckNoDevices,
+				ExpectError: regexp.MustCompile(".*Invalid Attribute Combination.*"),
+			},
+		},
+	})
+}
+
+var testDeploymentPrecheck = testProvider + `
+	data "ome_deployment_precheck" "precheck" {
+		template_name      = "` + TestRefTemplateName + `"
+		device_servicetags = ["` + DeviceSvcTag1 + `"]
+		sleep_interval     = 10
+		depends_on         = ["ome_template.terraform-acceptance-test-1"]
+	}
+`
+
+var testDeploymentPrecheckInvalidTemplate = testProvider + `
+	data "ome_deployment_precheck" "precheck" {
+		template_name      = "InvalidTemplate"
+		device_servicetags = ["` + DeviceSvcTag1 + `"]
+	}
+`
+
+var testDeploymentPrecheckNoDevices = testProvider + `
+	data "ome_deployment_precheck" "precheck" {
+		template_name = "` + TestRefTemplateName + `"
+	}
+`
+
+func TestDeploymentPrecheckProfiles(t *testing.T) {
+	existing := models.OMEServerProfiles{Value: []models.OMEServerProfile{{ID: 1, TargetID: 10}}}
+	current := models.OMEServerProfiles{Value: []models.OMEServerProfile{
+		{ID: 1, TargetID: 10},
+		{ID: 2, TargetID: 10, DeploymentTaskID: 500},
+		{ID: 3, TargetID: 11},
+		{ID: 4, TargetID: 12},
+		{ID: 5, TargetID: 11, DeploymentTaskID: 600},
+	}}
+	assert.Equal(t, []int64{2, 3}, getPrecheckProfileIDs(current, existing, 500, []int64{10, 11}))
+}
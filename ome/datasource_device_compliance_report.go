@@ -15,6 +15,7 @@ package ome
 
 import (
 	"context"
+	"terraform-provider-ome/clients"
 	"terraform-provider-ome/helper"
 	"terraform-provider-ome/models"
 
@@ -71,6 +72,16 @@ func (g *deviceComplianceReportDatasource) Read(ctx context.Context, req datasou
 		return
 	}
 
+	rules := map[string][]models.FirmwareComponentRule{}
+	for _, baselineRules := range plan.BaselineRules {
+		baselineName := baselineRules.BaselineName.ValueString()
+		rules[baselineName] = append(rules[baselineName], helper.NewFirmwareComponentRules(baselineRules.ComponentRules)...)
+		if err := clients.ValidateFirmwareComponentRules(rules[baselineName]); err != nil {
+			resp.Diagnostics.AddError("Invalid component rules of baseline "+baselineName, err.Error())
+			return
+		}
+	}
+
 	omeClient, d := g.p.createOMESession(ctx, "device_compliance_report Read")
 	resp.Diagnostics.Append(d...)
 	if d.HasError() {
@@ -85,6 +96,7 @@ func (g *deviceComplianceReportDatasource) Read(ctx context.Context, req datasou
 		return
 	}
 
+	clients.ApplyDeviceFirmwareComponentRules(complianceReports, rules)
 	vals, stateErr := helper.SetStateDeviceComplianceReport(ctx, complianceReports)
 	if stateErr != nil {
 		resp.Diagnostics.AddError(
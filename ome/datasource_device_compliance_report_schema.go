@@ -35,6 +35,7 @@ func omeDeviceReportSchema() map[string]schema.Attribute {
 			Computed:            true,
 			NestedObject:        schema.NestedAttributeObject{Attributes: omeSingleDeviceComplianceReportDataSchema()},
 		},
+		"baseline_component_rules": FirmwareBaselineComponentRulesDataSourceSchema(),
 		"device_ids": schema.ListAttribute{
 			MarkdownDescription: "Device Ids is the list of device ids that you want get the compliance report for. One of device_ids or device_service_tags or device_group_names is required",
 			Description:         "Device Ids is the list of device ids that you want get the compliance report for. One of device_ids or device_service_tags or device_group_names is required",
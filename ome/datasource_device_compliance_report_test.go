@@ -56,6 +56,17 @@ func TestDataSource_DeviceComplianceReport(t *testing.T) {
 					resource.TestCheckOutput("fetch", "true"),
 				),
 			},
+			// Should fetch the reports with the component rules of a baseline
+			{
+				Config: deviceComplianceReportComponentRules,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("fetch", "true"),
+				),
+			},
+			{
+				Config:      deviceComplianceReportComponentRulesErr,
+				ExpectError: regexp.MustCompile(`.*Invalid component rules of baseline*.`),
+			},
 			// Error getting device reports
 			{
 				PreConfig: func() {
@@ -105,3 +116,41 @@ output "fetch" {
 	value = length(data.ome_device_compliance_report.device_compliance_report_data.device_compliance_reports) > 0
 }
 `
+
+var deviceComplianceReportComponentRules = testProvider + `
+data "ome_device_compliance_report" "device_compliance_report_data" {
+	device_ids = [` + DeviceID1 + `]
+	baseline_component_rules = [
+		{
+			baseline_name = "tfacc_baseline_dell_1"
+			component_rules = [
+				{
+					action = "Exclude"
+					source_name = "*NIC*"
+				}
+			]
+		}
+	]
+}
+output "fetch" {
+	value = length(data.ome_device_compliance_report.device_compliance_report_data.device_compliance_reports) > 0
+}
+`
+
+var deviceComplianceReportComponentRulesErr = testProvider + `
+data "ome_device_compliance_report" "device_compliance_report_data" {
+	device_ids = [` + DeviceID1 + `]
+	baseline_component_rules = [
+		{
+			baseline_name = "tfacc_baseline_dell_1"
+			component_rules = [
+				{
+					action = "Exclude"
+					source_name = "*NIC*"
+					version = "22.5.7"
+				}
+			]
+		}
+	]
+}
+`
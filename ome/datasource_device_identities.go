@@ -0,0 +1,286 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &deviceIdentitiesDataSource{}
+	_ datasource.DataSourceWithConfigure = &deviceIdentitiesDataSource{}
+)
+
+// NewDeviceIdentitiesDataSource is a new datasource to read the virtual identities assigned to the devices of a deployment
+func NewDeviceIdentitiesDataSource() datasource.DataSource {
+	return &deviceIdentitiesDataSource{}
+}
+
+type deviceIdentitiesDataSource struct {
+	p *omeProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (d *deviceIdentitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*deviceIdentitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "device_identities"
+}
+
+func deviceIdentityAttributeSchema(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Description:         description,
+		Computed:            true,
+	}
+}
+
+// Schema implements datasource.DataSource
+func (d *deviceIdentitiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform DataSource is used to read the virtual identities, MAC addresses, iSCSI IQNs and FC WWPNs and WWNNs," +
+			" assigned from the identity pool of a template to the NIC partitions of the devices it is deployed on.",
+		Description: "This Terraform DataSource is used to read the virtual identities, MAC addresses, iSCSI IQNs and FC WWPNs and WWNNs," +
+			" assigned from the identity pool of a template to the NIC partitions of the devices it is deployed on.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the datasource, the ID of the template.",
+				Description:         "ID of the datasource, the ID of the template.",
+				Computed:            true,
+			},
+			"template_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the deployed template." +
+					" If a template with this ID is found, `template_name` will be ignored.",
+				Description: "ID of the deployed template." +
+					" If a template with this ID is found, 'template_name' will be ignored.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeastOneOf(path.MatchRoot("template_name")),
+				},
+			},
+			"template_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the deployed template.",
+				Description:         "Name of the deployed template.",
+				Optional:            true,
+			},
+			"device_ids": schema.SetAttribute{
+				MarkdownDescription: "Reads the identities of these device id(s) only." +
+					" Conflicts with `device_servicetags`.",
+				Description: "Reads the identities of these device id(s) only." +
+					" Conflicts with 'device_servicetags'.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ConflictsWith(path.MatchRoot("device_servicetags")),
+				},
+			},
+			"device_servicetags": schema.SetAttribute{
+				MarkdownDescription: "Reads the identities of these device servicetags only." +
+					" Conflicts with `device_ids`.",
+				Description: "Reads the identities of these device servicetags only." +
+					" Conflicts with 'device_ids'.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"identity_pool_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the identity pool of the template.",
+				Description:         "ID of the identity pool of the template.",
+				Computed:            true,
+			},
+			"identity_pool_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the identity pool of the template.",
+				Description:         "Name of the identity pool of the template.",
+				Computed:            true,
+			},
+			"identities": schema.ListNestedAttribute{
+				MarkdownDescription: "Identities assigned to every NIC partition of the devices, ordered by device, NIC and partition.",
+				Description:         "Identities assigned to every NIC partition of the devices, ordered by device, NIC and partition.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"device_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the device.",
+							Description:         "ID of the device.",
+							Computed:            true,
+						},
+						"service_tag":  deviceIdentityAttributeSchema("Service tag of the device."),
+						"profile_name": deviceIdentityAttributeSchema("Name of the server profile the identities were assigned through."),
+						"nic_id":       deviceIdentityAttributeSchema("FQDD of the NIC port, such as `NIC.Mezzanine.1A-1`."),
+						"partition": schema.Int64Attribute{
+							MarkdownDescription: "Partition of the NIC port, null for ports without partitions.",
+							Description:         "Partition of the NIC port, null for ports without partitions.",
+							Computed:            true,
+						},
+						"ethernet_mac": deviceIdentityAttributeSchema("Ethernet MAC address of the partition."),
+						"iscsi_mac":    deviceIdentityAttributeSchema("iSCSI MAC address of the partition."),
+						"iscsi_iqn":    deviceIdentityAttributeSchema("iSCSI initiator IQN of the partition."),
+						"fcoe_mac":     deviceIdentityAttributeSchema("FCoE FIP MAC address of the partition."),
+						"fcoe_wwpn":    deviceIdentityAttributeSchema("FCoE WWPN of the partition."),
+						"fcoe_wwnn":    deviceIdentityAttributeSchema("FCoE WWNN of the partition."),
+						"fc_wwpn":      deviceIdentityAttributeSchema("FC WWPN of the port."),
+						"fc_wwnn":      deviceIdentityAttributeSchema("FC WWNN of the port."),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (d *deviceIdentitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "datasource_device_identities read: started")
+	var state models.DeviceIdentities
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var serviceTags []string
+	var devIDs []int64
+	resp.Diagnostics.Append(state.DeviceServicetags.ElementsAs(ctx, &serviceTags, true)...)
+	resp.Diagnostics.Append(state.DeviceIDs.ElementsAs(ctx, &devIDs, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	omeClient, diags := d.p.createOMESession(ctx, "datasource_device_identities Read")
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	omeTemplate, err := omeClient.GetTemplateByIDOrName(state.TemplateID.ValueInt64(), state.TemplateName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeviceIdentities, err.Error())
+		return
+	}
+	if omeTemplate.IdentityPoolID == 0 {
+		resp.Diagnostics.AddError(clients.ErrGnrDeviceIdentities, fmt.Sprintf("template %s is not associated with an identity pool", omeTemplate.Name))
+		return
+	}
+	identityPool, err := omeClient.GetIdentityPoolByID(omeTemplate.IdentityPoolID)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeviceIdentities, err.Error())
+		return
+	}
+
+	// the identity pool may be shared by templates, the identities are limited to the devices the template is deployed on
+	serverProfiles, err := omeClient.GetServerProfileInfoByTemplateName(omeTemplate.Name)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeviceIdentities, err.Error())
+		return
+	}
+	targetIDs := map[int64]bool{}
+	for _, serverProfile := range serverProfiles.Value {
+		targetIDs[serverProfile.TargetID] = true
+	}
+	serviceTagsByID := map[int64]string{}
+	if len(serviceTags) > 0 || len(devIDs) > 0 {
+		devices, err := omeClient.GetDevices(serviceTags, devIDs, []string{})
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrDeviceIdentities, err.Error())
+			return
+		}
+		filteredIDs := map[int64]bool{}
+		for _, device := range devices {
+			filteredIDs[device.ID] = targetIDs[device.ID]
+			serviceTagsByID[device.ID] = device.DeviceServiceTag
+		}
+		targetIDs = filteredIDs
+	}
+
+	usage, err := omeClient.GetIdentityPoolUsage(identityPool.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrDeviceIdentities, err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(strconv.FormatInt(omeTemplate.ID, 10))
+	state.TemplateID = types.Int64Value(omeTemplate.ID)
+	state.TemplateName = types.StringValue(omeTemplate.Name)
+	state.IdentityPoolID = types.Int64Value(identityPool.ID)
+	state.IdentityPoolName = types.StringValue(identityPool.Name)
+	state.Identities = []models.DeviceIdentity{}
+	for _, identity := range clients.DeviceIdentitiesFromUsage(usage, serverProfiles.Value) {
+		if !targetIDs[identity.DeviceID] {
+			continue
+		}
+		if identity.ServiceTag == "" {
+			serviceTag, ok := serviceTagsByID[identity.DeviceID]
+			if !ok {
+				device, _ := omeClient.GetDevice("", identity.DeviceID)
+				serviceTag = device.DeviceServiceTag
+				serviceTagsByID[identity.DeviceID] = serviceTag
+			}
+			identity.ServiceTag = serviceTag
+		}
+		state.Identities = append(state.Identities, newDeviceIdentity(identity))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "datasource_device_identities read: finished")
+}
+
+// newDeviceIdentity converts the identities of a NIC partition to their tfsdk model, leaving the identities not assigned null
+func newDeviceIdentity(identity models.OMEDeviceIdentity) models.DeviceIdentity {
+	identityValue := func(value string) types.String {
+		if value == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(value)
+	}
+	deviceIdentity := models.DeviceIdentity{
+		DeviceID:    types.Int64Value(identity.DeviceID),
+		ServiceTag:  types.StringValue(identity.ServiceTag),
+		ProfileName: types.StringValue(identity.ProfileName),
+		NicID:       types.StringValue(identity.NicID),
+		Partition:   types.Int64Null(),
+		EthernetMac: identityValue(identity.EthernetMac),
+		IscsiMac:    identityValue(identity.IscsiMac),
+		IscsiIQN:    identityValue(identity.IscsiIQN),
+		FcoeMac:     identityValue(identity.FcoeMac),
+		FcoeWWPN:    identityValue(identity.FcoeWWPN),
+		FcoeWWNN:    identityValue(identity.FcoeWWNN),
+		FcWWPN:      identityValue(identity.FcWWPN),
+		FcWWNN:      identityValue(identity.FcWWNN),
+	}
+	if identity.Partition > 0 {
+		deviceIdentity.Partition = types.Int64Value(identity.Partition)
+	}
+	return deviceIdentity
+}
@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSource_DeviceIdentities(t *testing.T) {
+	if skipTest() {
+		t.Skip(SkipTestMsg)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeviceIdentities,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_device_identities.identities", "identity_pool_name", "IO1"),
+					resource.TestCheckResourceAttrSet("data.ome_device_identities.identities", "identities.0.nic_id"),
+					resource.TestCheckResourceAttr("data.ome_device_identities.identities", "identities.0.service_tag", DeviceSvcTag1),
+				),
+			},
+			{
+				Config:      testDeviceIdentitiesNoPool,
+				ExpectError: regexp.MustCompile(".*is not associated with an identity pool.*"),
+			},
+		},
+	})
+}
+
+var testDeviceIdentities = testProvider + `
+	resource "ome_template" "terraform-acceptance-test-1" {
+		name                 = "` + TestAccTemplateName + `"
+		refdevice_servicetag = "` + DeviceSvcTag1 + `"
+		fqdds                = "NIC"
+		identity_pool_name   = "IO1"
+	}
+
+	resource "ome_deployment" "deploy-template-identities" {
+		template_name      = resource.ome_template.terraform-acceptance-test-1.name
+		device_servicetags = ["` + DeviceSvcTag1 + `"]
+	}
+
+	data "ome_device_identities" "identities" {
+		template_name      = resource.ome_template.terraform-acceptance-test-1.name
+		device_servicetags = ["` + DeviceSvcTag1 + `"]
+		depends_on         = [ome_deployment.deploy-template-identities]
+	}
+`
+
+var testDeviceIdentitiesNoPool = testProvider + `
+	resource "ome_template" "terraform-acceptance-test-1" {
+		name                 = "` + TestAccTemplateName + `"
+		refdevice_servicetag = "` + DeviceSvcTag1 + `"
+		fqdds                = "NIC"
+	}
+
+	data "ome_device_identities" "identities" {
+		template_name = resource.ome_template.terraform-acceptance-test-1.name
+	}
+`
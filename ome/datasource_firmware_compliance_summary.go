@@ -0,0 +1,131 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultFirmwareSummaryLimit is the default number of worst devices and outdated components of the firmware compliance summary
+const defaultFirmwareSummaryLimit int64 = 10
+
+var (
+	_ datasource.DataSource              = &firmwareComplianceSummaryDatasource{}
+	_ datasource.DataSourceWithConfigure = &firmwareComplianceSummaryDatasource{}
+)
+
+// NewFirmwareComplianceSummaryDataSource - Creates a new firmware compliance summary datasource.
+func NewFirmwareComplianceSummaryDataSource() datasource.DataSource {
+	return &firmwareComplianceSummaryDatasource{}
+}
+
+type firmwareComplianceSummaryDatasource struct {
+	p *omeProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (g *firmwareComplianceSummaryDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	g.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*firmwareComplianceSummaryDatasource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "firmware_compliance_summary"
+}
+
+// Schema implements datasource.DataSource
+func (*firmwareComplianceSummaryDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform DataSource is used to summarize the firmware compliance of the devices of one or more firmware baselines from OME." +
+			" The information fetched from this data source can be used in dashboards or `check` blocks.",
+		Description: "This Terraform DataSource is used to summarize the firmware compliance of the devices of one or more firmware baselines from OME." +
+			" The information fetched from this data source can be used in dashboards or 'check' blocks.",
+		Attributes: FirmwareComplianceSummaryDataSchema(),
+	}
+}
+
+// Read implements datasource.DataSource
+func (g *firmwareComplianceSummaryDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var plan models.FirmwareComplianceSummaryData
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules := map[string][]models.FirmwareComponentRule{}
+	for _, baselineRules := range plan.BaselineRules {
+		baselineName := baselineRules.BaselineName.ValueString()
+		rules[baselineName] = append(rules[baselineName], helper.NewFirmwareComponentRules(baselineRules.ComponentRules)...)
+		if err := clients.ValidateFirmwareComponentRules(rules[baselineName]); err != nil {
+			resp.Diagnostics.AddError("Invalid component rules of baseline "+baselineName, err.Error())
+			return
+		}
+	}
+
+	var baselineNames, groupNames, deviceModels []string
+	resp.Diagnostics.Append(plan.BaselineNames.ElementsAs(ctx, &baselineNames, true)...)
+	resp.Diagnostics.Append(plan.DeviceGroupNames.ElementsAs(ctx, &groupNames, true)...)
+	resp.Diagnostics.Append(plan.DeviceModels.ElementsAs(ctx, &deviceModels, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	worstDevicesLimit, outdatedComponentsLimit := defaultFirmwareSummaryLimit, defaultFirmwareSummaryLimit
+	if !plan.WorstDevicesLimit.IsNull() {
+		worstDevicesLimit = plan.WorstDevicesLimit.ValueInt64()
+	}
+	if !plan.OutdatedComponentsLimit.IsNull() {
+		outdatedComponentsLimit = plan.OutdatedComponentsLimit.ValueInt64()
+	}
+
+	omeClient, d := g.p.createOMESession(ctx, "firmware_compliance_summary Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	deviceIDs, err := helper.GetFirmwareComplianceGroupDeviceIDs(omeClient, groupNames)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the devices of the groups", err.Error())
+		return
+	}
+
+	reports, err := helper.GetFirmwareComplianceSummaryReports(ctx, omeClient, baselineNames, rules)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching firmware baseline compliance reports", err.Error())
+		return
+	}
+
+	summary := clients.SummarizeFirmwareCompliance(reports, deviceIDs, deviceModels, int(worstDevicesLimit), int(outdatedComponentsLimit))
+	if err := helper.SetStateFirmwareComplianceSummary(ctx, summary, &plan); err != nil {
+		resp.Diagnostics.AddError("Error processing firmware compliance summary", err.Error())
+		return
+	}
+	plan.ID = types.Int64Value(0)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
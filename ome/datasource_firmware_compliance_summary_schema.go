@@ -0,0 +1,243 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FirmwareComplianceSummaryDataSchema returns the schema of the firmware compliance summary data source.
+func FirmwareComplianceSummaryDataSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the firmware compliance summary.",
+			Description:         "ID of the firmware compliance summary.",
+			Computed:            true,
+		},
+		"baseline_names": schema.ListAttribute{
+			MarkdownDescription: "Names of the firmware baselines to summarize.",
+			Description:         "Names of the firmware baselines to summarize.",
+			ElementType:         types.StringType,
+			Required:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"device_group_names": schema.ListAttribute{
+			MarkdownDescription: "Only summarize the devices of these groups.",
+			Description:         "Only summarize the devices of these groups.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"device_models": schema.ListAttribute{
+			MarkdownDescription: "Only summarize the devices of these models, for example `PowerEdge R650`. Case insensitive.",
+			Description:         "Only summarize the devices of these models, for example 'PowerEdge R650'. Case insensitive.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"baseline_component_rules": FirmwareBaselineComponentRulesDataSourceSchema(),
+		"worst_devices_limit": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of devices in `worst_devices`. Default value is `10`.",
+			Description:         "Maximum number of devices in 'worst_devices'. Default value is '10'.",
+			Optional:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"outdated_components_limit": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of components in `outdated_components`. Default value is `10`.",
+			Description:         "Maximum number of components in 'outdated_components'. Default value is '10'.",
+			Optional:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"total_devices": schema.Int64Attribute{
+			MarkdownDescription: "Number of devices summarized.",
+			Description:         "Number of devices summarized.",
+			Computed:            true,
+		},
+		"status_counts": schema.SingleNestedAttribute{
+			MarkdownDescription: "Number of devices per compliance status. A device targeted by several baselines counts once, with its most severe status.",
+			Description:         "Number of devices per compliance status. A device targeted by several baselines counts once, with its most severe status.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"ok": schema.Int64Attribute{
+					MarkdownDescription: "Number of compliant devices.",
+					Description:         "Number of compliant devices.",
+					Computed:            true,
+				},
+				"warning": schema.Int64Attribute{
+					MarkdownDescription: "Number of devices with a WARNING compliance status.",
+					Description:         "Number of devices with a WARNING compliance status.",
+					Computed:            true,
+				},
+				"critical": schema.Int64Attribute{
+					MarkdownDescription: "Number of devices with a CRITICAL compliance status.",
+					Description:         "Number of devices with a CRITICAL compliance status.",
+					Computed:            true,
+				},
+				"downgrade": schema.Int64Attribute{
+					MarkdownDescription: "Number of devices with a DOWNGRADE compliance status.",
+					Description:         "Number of devices with a DOWNGRADE compliance status.",
+					Computed:            true,
+				},
+				"unknown": schema.Int64Attribute{
+					MarkdownDescription: "Number of devices with an unknown compliance status.",
+					Description:         "Number of devices with an unknown compliance status.",
+					Computed:            true,
+				},
+			},
+		},
+		"reboot_required_devices": schema.Int64Attribute{
+			MarkdownDescription: "Number of devices needing a reboot to become compliant.",
+			Description:         "Number of devices needing a reboot to become compliant.",
+			Computed:            true,
+		},
+		"reboot_required_percentage": schema.Float64Attribute{
+			MarkdownDescription: "Percentage of the devices needing a reboot to become compliant.",
+			Description:         "Percentage of the devices needing a reboot to become compliant.",
+			Computed:            true,
+		},
+		"worst_devices": schema.ListNestedAttribute{
+			MarkdownDescription: "Devices out of compliance, the most severe compliance status first, then the most non compliant components.",
+			Description:         "Devices out of compliance, the most severe compliance status first, then the most non compliant components.",
+			Computed:            true,
+			NestedObject:        schema.NestedAttributeObject{Attributes: FirmwareComplianceDeviceSchema()},
+		},
+		"outdated_components": schema.ListNestedAttribute{
+			MarkdownDescription: "Components needing an upgrade, on the most devices first.",
+			Description:         "Components needing an upgrade, on the most devices first.",
+			Computed:            true,
+			NestedObject:        schema.NestedAttributeObject{Attributes: FirmwareOutdatedComponentSchema()},
+		},
+	}
+}
+
+// FirmwareBaselineComponentRulesDataSourceSchema returns the schema of the component rules of firmware baselines applied to compliance reports.
+func FirmwareBaselineComponentRulesDataSourceSchema() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Component rules of the firmware baselines, usually the `name` and `component_rules` of `ome_firmware_baseline` resources. Excluded components are reported with the update action EXCLUDED and pinned components are compared against their pinned version.",
+		Description:         "Component rules of the firmware baselines, usually the 'name' and 'component_rules' of 'ome_firmware_baseline' resources. Excluded components are reported with the update action EXCLUDED and pinned components are compared against their pinned version.",
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+			"baseline_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the firmware baseline the rules apply to.",
+				Description:         "Name of the firmware baseline the rules apply to.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"component_rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Component rules of the firmware baseline.",
+				Description:         "Component rules of the firmware baseline.",
+				Required:            true,
+				NestedObject:        schema.NestedAttributeObject{Attributes: FirmwareComponentRuleDataSourceSchema()},
+			},
+		}},
+	}
+}
+
+// FirmwareComplianceDeviceSchema returns the schema of the firmware compliance of a device.
+func FirmwareComplianceDeviceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"device_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the device.",
+			Description:         "ID of the device.",
+			Computed:            true,
+		},
+		"device_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the device.",
+			Description:         "Name of the device.",
+			Computed:            true,
+		},
+		"service_tag": schema.StringAttribute{
+			MarkdownDescription: "Service tag of the device.",
+			Description:         "Service tag of the device.",
+			Computed:            true,
+		},
+		"device_model": schema.StringAttribute{
+			MarkdownDescription: "Model of the device.",
+			Description:         "Model of the device.",
+			Computed:            true,
+		},
+		"compliance_status": schema.StringAttribute{
+			MarkdownDescription: "Most severe compliance status of the device across its baselines.",
+			Description:         "Most severe compliance status of the device across its baselines.",
+			Computed:            true,
+		},
+		"baseline_names": schema.ListAttribute{
+			MarkdownDescription: "Names of the summarized baselines targeting the device.",
+			Description:         "Names of the summarized baselines targeting the device.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"non_compliant_components": schema.Int64Attribute{
+			MarkdownDescription: "Number of components of the device out of compliance.",
+			Description:         "Number of components of the device out of compliance.",
+			Computed:            true,
+		},
+		"critical_components": schema.Int64Attribute{
+			MarkdownDescription: "Number of components of the device with a CRITICAL compliance status.",
+			Description:         "Number of components of the device with a CRITICAL compliance status.",
+			Computed:            true,
+		},
+		"reboot_required": schema.BoolAttribute{
+			MarkdownDescription: "Whether the device needs a reboot to become compliant.",
+			Description:         "Whether the device needs a reboot to become compliant.",
+			Computed:            true,
+		},
+	}
+}
+
+// FirmwareOutdatedComponentSchema returns the schema of a firmware component needing an upgrade.
+func FirmwareOutdatedComponentSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the component.",
+			Description:         "Name of the component.",
+			Computed:            true,
+		},
+		"component_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the component.",
+			Description:         "Type of the component.",
+			Computed:            true,
+		},
+		"compliance_status": schema.StringAttribute{
+			MarkdownDescription: "Most severe compliance status of the component across the devices.",
+			Description:         "Most severe compliance status of the component across the devices.",
+			Computed:            true,
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "Newest baseline version of the component.",
+			Description:         "Newest baseline version of the component.",
+			Computed:            true,
+		},
+		"device_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of devices needing an upgrade of the component.",
+			Description:         "Number of devices needing an upgrade of the component.",
+			Computed:            true,
+		},
+	}
+}
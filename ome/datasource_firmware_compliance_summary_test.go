@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-ome/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSource_ReadFirmwareComplianceSummary(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testFirmwareComplianceSummaryDS,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ome_firmware_compliance_summary.summary", "total_devices"),
+					resource.TestCheckResourceAttrSet("data.ome_firmware_compliance_summary.summary", "status_counts.ok"),
+					resource.TestCheckResourceAttrSet("data.ome_firmware_compliance_summary.summary", "reboot_required_percentage"),
+					resource.TestCheckOutput("counted", "true"),
+				),
+			},
+			// every component is excluded
+			{
+				Config: testFirmwareComplianceSummaryDSExcludeAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_firmware_compliance_summary.summary", "worst_devices.#", "0"),
+					resource.TestCheckResourceAttr("data.ome_firmware_compliance_summary.summary", "outdated_components.#", "0"),
+				),
+			},
+			// no device of the model
+			{
+				Config: testFirmwareComplianceSummaryDSModel,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_firmware_compliance_summary.summary", "total_devices", "0"),
+					resource.TestCheckResourceAttr("data.ome_firmware_compliance_summary.summary", "reboot_required_percentage", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSource_ReadFirmwareComplianceSummaryErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testFirmwareComplianceSummaryDSInvalidBaseline,
+				ExpectError: regexp.MustCompile(".*Error fetching firmware baseline compliance reports*"),
+			},
+			{
+				Config:      testFirmwareComplianceSummaryDSRuleErr,
+				ExpectError: regexp.MustCompile(".*Invalid component rules of baseline*"),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetFwBaselineComplianceReport, OptGeneric).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      testFirmwareComplianceSummaryDS,
+				ExpectError: regexp.MustCompile(`.*Mock error*.`),
+			},
+		},
+	})
+}
+
+var testFirmwareComplianceSummaryDS = testProvider + `
+
+	data "ome_firmware_compliance_summary" "summary" {
+		baseline_names = ["tfacc_baseline_dell_1"]
+	}
+
+	output "counted" {
+		value = sum(values(data.ome_firmware_compliance_summary.summary.status_counts)) == data.ome_firmware_compliance_summary.summary.total_devices
+	}
+`
+
+var testFirmwareComplianceSummaryDSExcludeAll = testProvider + `
+
+	data "ome_firmware_compliance_summary" "summary" {
+		baseline_names = ["tfacc_baseline_dell_1"]
+		baseline_component_rules = [
+			{
+				baseline_name = "tfacc_baseline_dell_1"
+				component_rules = [
+					{
+						action = "Exclude"
+						source_name = "*"
+					}
+				]
+			}
+		]
+	}
+`
+
+var testFirmwareComplianceSummaryDSModel = testProvider + `
+
+	data "ome_firmware_compliance_summary" "summary" {
+		baseline_names = ["tfacc_baseline_dell_1"]
+		device_models = ["tfacc_invalid_model"]
+	}
+`
+
+var testFirmwareComplianceSummaryDSInvalidBaseline = testProvider + `
+
+	data "ome_firmware_compliance_summary" "summary" {
+		baseline_names = ["tfacc_baseline_dell_invalid"]
+	}
+`
+
+var testFirmwareComplianceSummaryDSRuleErr = testProvider + `
+
+	data "ome_firmware_compliance_summary" "summary" {
+		baseline_names = ["tfacc_baseline_dell_1"]
+		baseline_component_rules = [
+			{
+				baseline_name = "tfacc_baseline_dell_1"
+				component_rules = [
+					{
+						action = "Pin"
+						component_id = "159"
+					}
+				]
+			}
+		]
+	}
+`
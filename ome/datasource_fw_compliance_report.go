@@ -16,6 +16,7 @@ package ome
 import (
 	"context"
 	"strconv"
+	"terraform-provider-ome/clients"
 	"terraform-provider-ome/helper"
 	"terraform-provider-ome/models"
 
@@ -82,6 +83,14 @@ func (g *fwBaselineCompReportDatasource) Read(ctx context.Context, req datasourc
 		return
 	}
 
+	rules := helper.NewFirmwareComponentRules(plan.ComponentRules)
+	if err := clients.ValidateFirmwareComponentRules(rules); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid component rules", err.Error(),
+		)
+		return
+	}
+
 	omeClient, d := g.p.createOMESession(ctx, "datasource_fw_compliance_report Read")
 	resp.Diagnostics.Append(d...)
 	if d.HasError() {
@@ -123,6 +132,7 @@ func (g *fwBaselineCompReportDatasource) Read(ctx context.Context, req datasourc
 		return
 	}
 	plan.ID = types.Int64Value(baselineID)
+	clients.ApplyFirmwareComponentRules(report.Value, rules)
 
 	plan.Report = helper.NewOmeFwComplianceReportList(report.Value)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
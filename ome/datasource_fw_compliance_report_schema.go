@@ -14,6 +14,8 @@ limitations under the License.
 package ome
 
 import (
+	"terraform-provider-ome/clients"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -50,6 +52,41 @@ func FwBaseComplianceReportDataSchema() map[string]schema.Attribute {
 			Computed:            true,
 			NestedObject:        schema.NestedAttributeObject{Attributes: FwBaseComplianceReportSchema()},
 		},
+		"component_rules": schema.ListNestedAttribute{
+			MarkdownDescription: "Component rules of the baseline, usually the `component_rules` of the `ome_firmware_baseline` resource. Excluded components are reported with the update action EXCLUDED and pinned components are compared against their pinned version.",
+			Description:         "Component rules of the baseline, usually the 'component_rules' of the 'ome_firmware_baseline' resource. Excluded components are reported with the update action EXCLUDED and pinned components are compared against their pinned version.",
+			Optional:            true,
+			NestedObject:        schema.NestedAttributeObject{Attributes: FirmwareComponentRuleDataSourceSchema()},
+		},
+	}
+}
+
+// FirmwareComponentRuleDataSourceSchema returns the schema of a firmware component rule applied to a compliance report.
+func FirmwareComponentRuleDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"action": schema.StringAttribute{
+			MarkdownDescription: "Action of the rule. Options are (Pin, Exclude).",
+			Description:         "Action of the rule. Options are (Pin, Exclude).",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(clients.FirmwareRulePin, clients.FirmwareRuleExclude),
+			},
+		},
+		"source_name": schema.StringAttribute{
+			MarkdownDescription: "Source name of the component. Case insensitive, `*` matches any characters.",
+			Description:         "Source name of the component. Case insensitive, '*' matches any characters.",
+			Optional:            true,
+		},
+		"component_id": schema.StringAttribute{
+			MarkdownDescription: "Component ID of the component, the target identifier in the compliance reports.",
+			Description:         "Component ID of the component, the target identifier in the compliance reports.",
+			Optional:            true,
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "Version the component is pinned to.",
+			Description:         "Version the component is pinned to.",
+			Optional:            true,
+		},
 	}
 }
 
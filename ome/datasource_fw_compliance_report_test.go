@@ -109,6 +109,59 @@ func TestDataSource_ReadFwCompReportErrRet(t *testing.T) {
 	})
 }
 
+func TestDataSource_ReadFwCompReportComponentRules(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// every component is excluded
+			{
+				Config: testFwBaselineCompReportDSExcludeAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("excluded", "true")),
+			},
+			{
+				Config:      testFwBaselineCompReportDSRuleErr,
+				ExpectError: regexp.MustCompile(".*Invalid component rules*"),
+			},
+		},
+	})
+}
+
+var testFwBaselineCompReportDSExcludeAll = testProvider + `
+
+	data "ome_firmware_baseline_compliance_report" "report" {
+		baseline_name = "tfacc_baseline_dell_1"
+		component_rules = [
+			{
+				action = "Exclude"
+				source_name = "*"
+			}
+		]
+	}
+
+	output "excluded" {
+		value = alltrue(flatten([for report in data.ome_firmware_baseline_compliance_report.report.firmware_compliance_reports :
+			[for component in report.component_compliance_reports : component.update_action == "EXCLUDED"]]))
+	}
+`
+
+var testFwBaselineCompReportDSRuleErr = testProvider + `
+
+	data "ome_firmware_baseline_compliance_report" "report" {
+		baseline_name = "tfacc_baseline_dell_1"
+		component_rules = [
+			{
+				action = "Pin"
+				component_id = "159"
+			}
+		]
+	}
+`
+
 var testFwBaselineCompReportDSInvalidBaseline = testProvider + `
 	
 	data "ome_firmware_baseline_compliance_report" "cr" {
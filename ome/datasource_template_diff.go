@@ -0,0 +1,323 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &templateDiffDataSource{}
+	_ datasource.DataSourceWithConfigure = &templateDiffDataSource{}
+)
+
+// NewTemplateDiffDataSource is a new datasource to compare the attributes of templates
+func NewTemplateDiffDataSource() datasource.DataSource {
+	return &templateDiffDataSource{}
+}
+
+type templateDiffDataSource struct {
+	p *omeProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (t *templateDiffDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	t.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*templateDiffDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "template_diff"
+}
+
+// Schema implements datasource.DataSource
+func (t *templateDiffDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform DataSource is used to compare the attributes of a template with another template or with the current configuration of a device." +
+			" A device is compared through a temporary template created from the device and deleted once compared.",
+		Description: "This Terraform DataSource is used to compare the attributes of a template with another template or with the current configuration of a device." +
+			" A device is compared through a temporary template created from the device and deleted once compared.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the template diff, made of the IDs of the compared templates.",
+				Description:         "ID of the template diff, made of the IDs of the compared templates.",
+				Computed:            true,
+			},
+			"source_template_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the template the target is compared to.",
+				Description:         "Name of the template the target is compared to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"target_template_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the template compared to the source template." +
+					" Conflicts with `target_device_id` and `target_device_servicetag`.",
+				Description: "Name of the template compared to the source template." +
+					" Conflicts with 'target_device_id' and 'target_device_servicetag'.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("target_device_id"), path.MatchRoot("target_device_servicetag")),
+				},
+			},
+			"target_device_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the device whose configuration is compared to the source template." +
+					" Conflicts with `target_template_name` and `target_device_servicetag`.",
+				Description: "ID of the device whose configuration is compared to the source template." +
+					" Conflicts with 'target_template_name' and 'target_device_servicetag'.",
+				Optional: true,
+			},
+			"target_device_servicetag": schema.StringAttribute{
+				MarkdownDescription: "Service tag of the device whose configuration is compared to the source template." +
+					" Conflicts with `target_template_name` and `target_device_id`.",
+				Description: "Service tag of the device whose configuration is compared to the source template." +
+					" Conflicts with 'target_template_name' and 'target_device_id'.",
+				Optional: true,
+			},
+			"fqdds": schema.StringAttribute{
+				MarkdownDescription: "Comma seperated values of the components of the device captured in the temporary template." +
+					" Valid values are `iDRAC`, `System`, `BIOS`, `NIC`, `LifeCycleController`, `RAID`, `EventFilters` and `All`." +
+					" Default value is `All`.",
+				Description: "Comma seperated values of the components of the device captured in the temporary template." +
+					" Valid values are 'iDRAC', 'System', 'BIOS', 'NIC', 'LifeCycleController', 'RAID', 'EventFilters' and 'All'." +
+					" Default value is 'All'.",
+				Optional: true,
+				Validators: []validator.String{
+					validFqddsValidator{},
+				},
+			},
+			"include_ignored": schema.BoolAttribute{
+				MarkdownDescription: "Compares the attributes ignored in either template." +
+					" Default value is `false`.",
+				Description: "Compares the attributes ignored in either template." +
+					" Default value is 'false'.",
+				Optional: true,
+			},
+			"job_retry_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of times the job creating the temporary template of the device has to be polled." +
+					fmt.Sprintf(" Default value is `%d`.", RetryCount),
+				Description: "Number of times the job creating the temporary template of the device has to be polled." +
+					fmt.Sprintf(" Default value is '%d'.", RetryCount),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"sleep_interval": schema.Int64Attribute{
+				MarkdownDescription: "Sleep time interval in seconds between the polls of the job creating the temporary template of the device." +
+					fmt.Sprintf(" Default value is `%d`.", SleepInterval),
+				Description: "Sleep time interval in seconds between the polls of the job creating the temporary template of the device." +
+					fmt.Sprintf(" Default value is '%d'.", SleepInterval),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"has_differences": schema.BoolAttribute{
+				MarkdownDescription: "Whether attributes are added, removed or changed from the source to the target.",
+				Description:         "Whether attributes are added, removed or changed from the source to the target.",
+				Computed:            true,
+			},
+			"added":   templateDiffAttributesSchema("Attributes of the target missing from the source template."),
+			"removed": templateDiffAttributesSchema("Attributes of the source template missing from the target."),
+			"changed": templateDiffAttributesSchema("Attributes whose values differ between the source template and the target."),
+		},
+	}
+}
+
+func templateDiffAttributesSchema(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Description:         description,
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"path": schema.StringAttribute{
+					MarkdownDescription: "Path of the attribute, made of the names of the attribute groups and the name of the attribute separated by commas.",
+					Description:         "Path of the attribute, made of the names of the attribute groups and the name of the attribute separated by commas.",
+					Computed:            true,
+				},
+				"component": schema.StringAttribute{
+					MarkdownDescription: "Component of the attribute, the first attribute group of its path.",
+					Description:         "Component of the attribute, the first attribute group of its path.",
+					Computed:            true,
+				},
+				"source_value": schema.StringAttribute{
+					MarkdownDescription: "Value of the attribute in the source template, null when the attribute is added.",
+					Description:         "Value of the attribute in the source template, null when the attribute is added.",
+					Computed:            true,
+				},
+				"target_value": schema.StringAttribute{
+					MarkdownDescription: "Value of the attribute in the target, null when the attribute is removed.",
+					Description:         "Value of the attribute in the target, null when the attribute is removed.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (t *templateDiffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "datasource_template_diff read: started")
+	var state models.TemplateDiff
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	omeClient, d := t.p.createOMESession(ctx, "datasource_template_diff Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	sourceTemplate, err := getTemplateToCompare(omeClient, state.SourceTemplateName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrTemplateDiff, err.Error())
+		return
+	}
+
+	var targetTemplateID int64
+	if state.TargetTemplateName.ValueString() != "" {
+		targetTemplate, err := getTemplateToCompare(omeClient, state.TargetTemplateName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrTemplateDiff, err.Error())
+			return
+		}
+		targetTemplateID = targetTemplate.ID
+	} else {
+		tflog.Info(ctx, "datasource_template_diff read: creating a temporary template from the device")
+		targetTemplateID, err = createDeviceTemplateToCompare(omeClient, state, sourceTemplate)
+		if targetTemplateID > 0 {
+			defer func() {
+				_, _ = omeClient.Delete(fmt.Sprintf(clients.TemplateAPI+"(%d)", targetTemplateID), nil, nil)
+			}()
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(clients.ErrGnrTemplateDiff, err.Error())
+			return
+		}
+	}
+
+	sourceAttributes, err := omeClient.GetTemplateAttributes(sourceTemplate.ID, []models.Attribute{}, true)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrTemplateDiff, err.Error())
+		return
+	}
+	targetAttributes, err := omeClient.GetTemplateAttributes(targetTemplateID, []models.Attribute{}, true)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrTemplateDiff, err.Error())
+		return
+	}
+
+	diff := clients.DiffTemplateAttributes(sourceAttributes, targetAttributes, state.IncludeIgnored.ValueBool())
+	state.ID = types.StringValue(fmt.Sprintf("%d:%d", sourceTemplate.ID, targetTemplateID))
+	state.Added = newTemplateDiffAttributes(diff.Added, false, true)
+	state.Removed = newTemplateDiffAttributes(diff.Removed, true, false)
+	state.Changed = newTemplateDiffAttributes(diff.Changed, true, true)
+	state.HasDifferences = types.BoolValue(len(diff.Added)+len(diff.Removed)+len(diff.Changed) > 0)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "datasource_template_diff read: finished")
+}
+
+// getTemplateToCompare returns the template with the given name
+func getTemplateToCompare(omeClient *clients.Client, name string) (models.OMETemplate, error) {
+	template, err := omeClient.GetTemplateByName(name)
+	if err == nil && template.Name == "" {
+		err = fmt.Errorf("template %s could not be found", name)
+	}
+	return template, err
+}
+
+// createDeviceTemplateToCompare creates a template from the target device and waits for its creation job
+func createDeviceTemplateToCompare(omeClient *clients.Client, state models.TemplateDiff, sourceTemplate models.OMETemplate) (int64, error) {
+	deviceID, err := omeClient.ValidateDevice(state.TargetDeviceServicetag.ValueString(), state.TargetDeviceID.ValueInt64())
+	if err != nil {
+		return -1, err
+	}
+	fqdds := "All"
+	if state.FQDDS.ValueString() != "" {
+		fqdds = strings.ReplaceAll(state.FQDDS.ValueString(), " ", "")
+	}
+	templateID, err := omeClient.CreateTemplate(models.CreateTemplate{
+		Fqdds:          fqdds,
+		ViewTypeID:     sourceTemplate.ViewTypeID,
+		SourceDeviceID: deviceID,
+		Name:           fmt.Sprintf("%s-diff-%d", sourceTemplate.Name, time.Now().Unix()),
+		Description:    fmt.Sprintf("Temporary template comparing device %d to template %s", deviceID, sourceTemplate.Name),
+	})
+	if err != nil {
+		return -1, err
+	}
+
+	retryCount, sleepInterval := int64(RetryCount), int64(SleepInterval)
+	if !state.JobRetryCount.IsNull() {
+		retryCount = state.JobRetryCount.ValueInt64()
+	}
+	if !state.SleepInterval.IsNull() {
+		sleepInterval = state.SleepInterval.ValueInt64()
+	}
+	time.Sleep(SleepTimeBeforeJob * time.Second)
+	template, _, err := omeClient.GetTemplateByID(templateID)
+	if err != nil {
+		return templateID, err
+	}
+	if isSuccess, message := omeClient.TrackJob(template.TaskID, retryCount, sleepInterval); !isSuccess {
+		return templateID, fmt.Errorf("unable to create the template of the device: %s", message)
+	}
+	return templateID, nil
+}
+
+// newTemplateDiffAttributes converts the attribute differences to their tfsdk model
+func newTemplateDiffAttributes(differences []models.TemplateAttributeDifference, withSource, withTarget bool) []models.TemplateDiffAttribute {
+	attributes := make([]models.TemplateDiffAttribute, 0, len(differences))
+	for _, difference := range differences {
+		attribute := models.TemplateDiffAttribute{
+			Path:        types.StringValue(difference.DisplayName),
+			Component:   types.StringValue(strings.SplitN(difference.DisplayName, ",", 2)[0]),
+			SourceValue: types.StringNull(),
+			TargetValue: types.StringNull(),
+		}
+		if withSource {
+			attribute.SourceValue = types.StringValue(difference.SourceValue)
+		}
+		if withTarget {
+			attribute.TargetValue = types.StringValue(difference.TargetValue)
+		}
+		attributes = append(attributes, attribute)
+	}
+	return attributes
+}
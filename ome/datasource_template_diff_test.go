@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSource_TemplateDiff(t *testing.T) {
+	temps := initTemplates(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: justProvider + temps.templateSvcTag1Full,
+			},
+			{
+				Config: testTemplateDiff + temps.templateSvcTag1Full,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ome_template_diff.same", "has_differences", "false"),
+					resource.TestCheckResourceAttr("data.ome_template_diff.same", "changed.#", "0"),
+					resource.TestCheckResourceAttrSet("data.ome_template_diff.device", "has_differences"),
+				),
+			},
+			{
+				Config:      testTemplateDiffInvalid,
+				ExpectError: regexp.MustCompile(".*could not be found.*"),
+			},
+			{
+				Config:      testTemplateDiffNoTarget,
+				ExpectError: regexp.MustCompile(".*Invalid Attribute Combination.*"),
+			},
+		},
+	})
+}
+
+var testTemplateDiff = testProvider + `
+	data "ome_template_diff" "same" {
+		source_template_name = "` + TestRefTemplateName + `"
+		target_template_name = "` + TestRefTemplateName + `"
+		depends_on           = ["ome_template.terraform-acceptance-test-1"]
+	}
+
+	data "ome_template_diff" "device" {
+		source_template_name     = "` + TestRefTemplateName + `"
+		target_device_servicetag = "` + DeviceSvcTag1 + `"
+		fqdds                    = "BIOS"
+		depends_on               = ["ome_template.terraform-acceptance-test-1"]
+	}
+`
+
+var testTemplateDiffInvalid = testProvider + `
+	data "ome_template_diff" "invalid" {
+		source_template_name = "InvalidTemplate"
+		target_template_name = "` + TestRefTemplateName + `"
+	}
+`
+
+var testTemplateDiffNoTarget = testProvider + `
+	data "ome_template_diff" "invalid" {
+		source_template_name = "` + TestRefTemplateName + `"
+	}
+`
@@ -0,0 +1,178 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"terraform-provider-ome/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &templateExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &templateExportDataSource{}
+)
+
+// NewTemplateExportDataSource is a new datasource to export the content of a template
+func NewTemplateExportDataSource() datasource.DataSource {
+	return &templateExportDataSource{}
+}
+
+type templateExportDataSource struct {
+	p *omeProvider
+}
+
+// Configure implements datasource.DataSourceWithConfigure
+func (t *templateExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	t.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata implements datasource.DataSource
+func (*templateExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "template_export"
+}
+
+// Schema implements datasource.DataSource
+func (t *templateExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This Terraform DataSource is used to export the XML content of a template from OME." +
+			" The exported content can be versioned and used as the `content` of an `ome_template` on another appliance once exported with `redact_passwords` set to `false`.",
+		Description: "This Terraform DataSource is used to export the XML content of a template from OME." +
+			" The exported content can be versioned and used as the 'content' of an 'ome_template' on another appliance once exported with 'redact_passwords' set to 'false'.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the template.",
+				Description:         "ID of the template.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the template.",
+				Description:         "Name of the template.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"redact_passwords": schema.BoolAttribute{
+				MarkdownDescription: "Replaces the values of the attributes holding passwords, community strings and keys with `" + utils.SCPRedactedValue + "`." +
+					" Redacted content must not be used as the `content` of an `ome_template`, the redacted values would be imported as the passwords," +
+					" set it to `false` to promote a template." +
+					" Default value is `true`.",
+				Description: "Replaces the values of the attributes holding passwords, community strings and keys with '" + utils.SCPRedactedValue + "'." +
+					" Redacted content must not be used as the 'content' of an 'ome_template', the redacted values would be imported as the passwords," +
+					" set it to 'false' to promote a template." +
+					" Default value is 'true'.",
+				Optional: true,
+			},
+			"fqdds": schema.ListAttribute{
+				MarkdownDescription: "Keeps only the components whose FQDD starts with one of the values, for example `BIOS` or `NIC.Integrated.1`." +
+					" All the components are exported when not set.",
+				Description: "Keeps only the components whose FQDD starts with one of the values, for example 'BIOS' or 'NIC.Integrated.1'." +
+					" All the components are exported when not set.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Normalised XML content of the template, after the redaction and the FQDD filter.",
+				Description:         "Normalised XML content of the template, after the redaction and the FQDD filter.",
+				Computed:            true,
+			},
+			"attributes": schema.MapAttribute{
+				MarkdownDescription: "Values of the attributes of the exported content, keyed by `FQDD/attribute name`," +
+					" for example `BIOS.Setup.1-1/BootMode`.",
+				Description: "Values of the attributes of the exported content, keyed by 'FQDD/attribute name'," +
+					" for example 'BIOS.Setup.1-1/BootMode'.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource
+func (t *templateExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Trace(ctx, "datasource_template_export read: started")
+	var state models.TemplateExport
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	omeClient, d := t.p.createOMESession(ctx, "datasource_template_export Read")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	templateName := state.Name.ValueString()
+	omeTemplate, err := omeClient.GetTemplateByName(templateName)
+	if err == nil && omeTemplate.Name == "" {
+		err = fmt.Errorf("template %s could not be found", templateName)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrExportTemplate, err.Error())
+		return
+	}
+
+	content, err := omeClient.ExportTemplate(omeTemplate.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrExportTemplate, err.Error())
+		return
+	}
+	scp, err := utils.ParseSCP(content)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrGnrExportTemplate, err.Error())
+		return
+	}
+
+	if !state.FQDDs.IsNull() {
+		scp.FilterFQDDs(utils.ConvertListValueToStringSlice(state.FQDDs))
+	}
+	if state.RedactPasswords.IsNull() || state.RedactPasswords.ValueBool() {
+		redacted := scp.Redact()
+		tflog.Debug(ctx, fmt.Sprintf("datasource_template_export read: redacted %d attributes", redacted))
+	}
+
+	scp.Normalize()
+
+	attributes, diags := types.MapValueFrom(ctx, types.StringType, scp.AttributeMap())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ID = types.StringValue(fmt.Sprintf("%d", omeTemplate.ID))
+	state.Content = types.StringValue(scp.String())
+	state.Attributes = attributes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "datasource_template_export read: finished")
+}
@@ -0,0 +1,68 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSource_TemplateExport(t *testing.T) {
+	temps := initTemplates(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: justProvider + temps.templateSvcTag1Full,
+			},
+			{
+				Config: testTemplateExport + temps.templateSvcTag1Full,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ome_template_export.all", "id", "ome_template.terraform-acceptance-test-1", "id"),
+					resource.TestMatchResourceAttr("data.ome_template_export.all", "content", regexp.MustCompile(`^<SystemConfiguration`)),
+					resource.TestMatchResourceAttr("data.ome_template_export.bios", "content", regexp.MustCompile(`FQDD="BIOS.Setup.1-1"`)),
+					resource.TestCheckNoResourceAttr("data.ome_template_export.bios", "attributes.iDRAC.Embedded.1/Users.2#UserName"),
+				),
+			},
+			{
+				Config:      testTemplateExportInvalid,
+				ExpectError: regexp.MustCompile(".*could not be found.*"),
+			},
+		},
+	})
+}
+
+var testTemplateExport = testProvider + `
+	data "ome_template_export" "all" {
+		name       = "` + TestRefTemplateName + `"
+		depends_on = ["ome_template.terraform-acceptance-test-1"]
+	}
+
+	data "ome_template_export" "bios" {
+		name             = "` + TestRefTemplateName + `"
+		fqdds            = ["BIOS"]
+		redact_passwords = false
+		depends_on       = ["ome_template.terraform-acceptance-test-1"]
+	}
+`
+
+var testTemplateExportInvalid = testProvider + `
+	data "ome_template_export" "invalid" {
+		name = "InvalidTemplate"
+	}
+`
@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"encoding/json"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// sessionPrivateKey - key of the session details in the private data of the ephemeral session
+	sessionPrivateKey = "session"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &sessionEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &sessionEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &sessionEphemeralResource{}
+)
+
+// NewSessionEphemeralResource is a new ephemeral resource for OME sessions
+func NewSessionEphemeralResource() ephemeral.EphemeralResource {
+	return &sessionEphemeralResource{}
+}
+
+type sessionEphemeralResource struct {
+	p *omeProvider
+}
+
+// Configure implements ephemeral.EphemeralResourceWithConfigure
+func (r *sessionEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.p = req.ProviderData.(*omeProvider)
+}
+
+// Metadata implements ephemeral.EphemeralResource
+func (r *sessionEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "session"
+}
+
+// Schema implements ephemeral.EphemeralResource
+func (r *sessionEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This terraform ephemeral resource opens an OME session with the credentials of the provider." +
+			" The session token can be used by other tools to call the OME APIs and is never written to the state." +
+			" The session is removed from OME when terraform closes the ephemeral resource.",
+		Description: "This terraform ephemeral resource opens an OME session with the credentials of the provider." +
+			" The session token can be used by other tools to call the OME APIs and is never written to the state." +
+			" The session is removed from OME when terraform closes the ephemeral resource.",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Authentication token of the session, to be sent in the `X-Auth-Token` header.",
+				Description:         "Authentication token of the session, to be sent in the 'X-Auth-Token' header.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"session_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the session.",
+				Description:         "ID of the session.",
+				Computed:            true,
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of OME, of the form `https://host:port`.",
+				Description:         "Base URL of OME, of the form 'https://host:port'.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Open implements ephemeral.EphemeralResource
+func (r *sessionEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	tflog.Trace(ctx, "ephemeral_session open: started")
+	omeClient, d := r.p.createOMESession(ctx, "ephemeral_session Open")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+
+	privateData, err := json.Marshal(models.SessionPrivateData{
+		Token:     omeClient.GetSessionToken(),
+		SessionID: omeClient.GetSessionID(),
+	})
+	if err != nil {
+		omeClient.RemoveSession()
+		resp.Diagnostics.AddError(clients.ErrCreateSession, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, sessionPrivateKey, privateData)...)
+	if resp.Diagnostics.HasError() {
+		omeClient.RemoveSession()
+		return
+	}
+
+	session := models.Session{
+		Token:     types.StringValue(omeClient.GetSessionToken()),
+		SessionID: types.StringValue(omeClient.GetSessionID()),
+		BaseURL:   types.StringValue(omeClient.GetURL()),
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &session)...)
+	tflog.Trace(ctx, "ephemeral_session open: finished")
+}
+
+// Close implements ephemeral.EphemeralResourceWithClose
+func (r *sessionEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	tflog.Trace(ctx, "ephemeral_session close: started")
+	privateData, d := req.Private.GetKey(ctx, sessionPrivateKey)
+	resp.Diagnostics.Append(d...)
+	if d.HasError() || privateData == nil {
+		return
+	}
+	var sessionData models.SessionPrivateData
+	if err := json.Unmarshal(privateData, &sessionData); err != nil {
+		resp.Diagnostics.AddError(clients.ErrRemoveSession, err.Error())
+		return
+	}
+
+	omeClient, err := clients.NewClient(*r.p.clientOpt)
+	if err != nil {
+		resp.Diagnostics.AddError(clients.ErrCreateClient, err.Error())
+		return
+	}
+	omeClient.SetSessionParams(sessionData.Token, sessionData.SessionID)
+	if _, err := omeClient.RemoveSession(); err != nil {
+		resp.Diagnostics.AddError(clients.ErrRemoveSession, err.Error())
+		return
+	}
+	tflog.Trace(ctx, "ephemeral_session close: finished")
+}
@@ -0,0 +1,233 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/helper"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// firmwareGuardSeverities are the compliance statuses the firmware compliance guard can be tripped from
+var firmwareGuardSeverities = []string{string(models.DOWNGRADE), string(models.WARNING), string(models.CRITICAL)}
+
+// firmwareComplianceGuardData holds the firmware_compliance_guard configuration of the provider
+type firmwareComplianceGuardData struct {
+	Severity      types.String                        `tfsdk:"severity"`
+	Policy        types.String                        `tfsdk:"policy"`
+	BaselineNames types.List                          `tfsdk:"baseline_names"`
+	BaselineRules []models.BaselineComponentRulesData `tfsdk:"baseline_component_rules"`
+}
+
+func firmwareComplianceGuardSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Checks the firmware compliance of the devices targeted by `ome_deployment` and `ome_configuration_compliance`" +
+			" while planning their creation or update, the devices whose firmware is out of compliance at `severity` or worse are reported with their offending components." +
+			" The guard is disabled when the block is not set.",
+		Description: "Checks the firmware compliance of the devices targeted by 'ome_deployment' and 'ome_configuration_compliance'" +
+			" while planning their creation or update, the devices whose firmware is out of compliance at 'severity' or worse are reported with their offending components." +
+			" The guard is disabled when the block is not set.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"severity": schema.StringAttribute{
+				MarkdownDescription: "Lowest firmware compliance status tripping the guard, the statuses rank from `DOWNGRADE` to `WARNING` to `CRITICAL`." +
+					makeSchemaAcceptedValues(firmwareGuardSeverities, "`"),
+				Description: "Lowest firmware compliance status tripping the guard, the statuses rank from 'DOWNGRADE' to 'WARNING' to 'CRITICAL'." +
+					makeSchemaAcceptedValues(firmwareGuardSeverities, "'"),
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(firmwareGuardSeverities...),
+				},
+			},
+			"policy": schema.StringAttribute{
+				MarkdownDescription: "Reports the devices tripping the guard as warnings or as errors failing the plan." +
+					makeSchemaAcceptedValues([]string{clients.FirmwareGuardPolicyWarning, clients.FirmwareGuardPolicyError}, "`") +
+					fmt.Sprintf(" Default value is `%s`.", clients.FirmwareGuardPolicyError),
+				Description: "Reports the devices tripping the guard as warnings or as errors failing the plan." +
+					makeSchemaAcceptedValues([]string{clients.FirmwareGuardPolicyWarning, clients.FirmwareGuardPolicyError}, "'") +
+					fmt.Sprintf(" Default value is '%s'.", clients.FirmwareGuardPolicyError),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(clients.FirmwareGuardPolicyWarning, clients.FirmwareGuardPolicyError),
+				},
+			},
+			"baseline_names": schema.ListAttribute{
+				MarkdownDescription: "Names of the firmware baselines the compliance is read from. All the baselines targeting the devices are read when not set.",
+				Description:         "Names of the firmware baselines the compliance is read from. All the baselines targeting the devices are read when not set.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"baseline_component_rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Component rules of the firmware baselines applied to the compliance before it is checked, usually the `name` and `component_rules` of `ome_firmware_baseline` resources." +
+					" Excluded components do not trip the guard and pinned components are compared against their pinned version.",
+				Description: "Component rules of the firmware baselines applied to the compliance before it is checked, usually the 'name' and 'component_rules' of 'ome_firmware_baseline' resources." +
+					" Excluded components do not trip the guard and pinned components are compared against their pinned version.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+					"baseline_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the firmware baseline the rules apply to.",
+						Description:         "Name of the firmware baseline the rules apply to.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"component_rules": schema.ListNestedAttribute{
+						MarkdownDescription: "Component rules of the firmware baseline.",
+						Description:         "Component rules of the firmware baseline.",
+						Required:            true,
+						NestedObject:        schema.NestedAttributeObject{Attributes: firmwareGuardComponentRuleSchema()},
+					},
+				}},
+			},
+		},
+	}
+}
+
+// firmwareGuardComponentRuleSchema returns the schema of a firmware component rule applied by the firmware compliance guard
+func firmwareGuardComponentRuleSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"action": schema.StringAttribute{
+			MarkdownDescription: "Action of the rule. Options are (Pin, Exclude).",
+			Description:         "Action of the rule. Options are (Pin, Exclude).",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(clients.FirmwareRulePin, clients.FirmwareRuleExclude),
+			},
+		},
+		"source_name": schema.StringAttribute{
+			MarkdownDescription: "Source name of the component. Case insensitive, `*` matches any characters.",
+			Description:         "Source name of the component. Case insensitive, '*' matches any characters.",
+			Optional:            true,
+		},
+		"component_id": schema.StringAttribute{
+			MarkdownDescription: "Component ID of the component, the target identifier in the compliance reports.",
+			Description:         "Component ID of the component, the target identifier in the compliance reports.",
+			Optional:            true,
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "Version the component is pinned to.",
+			Description:         "Version the component is pinned to.",
+			Optional:            true,
+		},
+	}
+}
+
+// newFirmwareComplianceGuard returns the firmware compliance guard of the provider configuration, nil when the guard is not set
+func newFirmwareComplianceGuard(ctx context.Context, guard types.Object) (*models.FirmwareComplianceGuard, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if guard.IsNull() {
+		return nil, diags
+	}
+	if guard.IsUnknown() {
+		diags.AddError("Invalid firmware compliance guard", "Cannot use unknown value as firmware_compliance_guard")
+		return nil, diags
+	}
+	var data firmwareComplianceGuardData
+	diags.Append(guard.As(ctx, &data, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	baselineNames := []string{}
+	diags.Append(data.BaselineNames.ElementsAs(ctx, &baselineNames, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	componentRules := map[string][]models.FirmwareComponentRule{}
+	for _, baselineRules := range data.BaselineRules {
+		baselineName := baselineRules.BaselineName.ValueString()
+		componentRules[baselineName] = append(componentRules[baselineName], helper.NewFirmwareComponentRules(baselineRules.ComponentRules)...)
+		if err := clients.ValidateFirmwareComponentRules(componentRules[baselineName]); err != nil {
+			diags.AddError("Invalid component rules of baseline "+baselineName, err.Error())
+			return nil, diags
+		}
+	}
+	policy := clients.FirmwareGuardPolicyError
+	if !data.Policy.IsNull() {
+		policy = data.Policy.ValueString()
+	}
+	return &models.FirmwareComplianceGuard{
+		Severity:       data.Severity.ValueString(),
+		Policy:         policy,
+		BaselineNames:  baselineNames,
+		ComponentRules: componentRules,
+	}, diags
+}
+
+// firmwareGuardTargets returns the known device ids and service tags of a plan, the unknown ones can not be checked yet
+func firmwareGuardTargets(deviceIDs []attr.Value, serviceTags []attr.Value) ([]int64, []string) {
+	ids := []int64{}
+	for _, value := range deviceIDs {
+		if id, ok := value.(types.Int64); ok && !id.IsUnknown() && !id.IsNull() {
+			ids = append(ids, id.ValueInt64())
+		}
+	}
+	tags := []string{}
+	for _, value := range serviceTags {
+		if tag, ok := value.(types.String); ok && !tag.IsUnknown() && !tag.IsNull() {
+			tags = append(tags, tag.ValueString())
+		}
+	}
+	return ids, tags
+}
+
+// checkFirmwareCompliance reports the targeted devices tripping the firmware compliance guard of the provider.
+// The diagnostics follow the policy of the guard, including when the compliance can not be read.
+func (p *omeProvider) checkFirmwareCompliance(ctx context.Context, omeClient *clients.Client, deviceIDs []int64, serviceTags []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	guard := p.firmwareGuard
+	if guard == nil || (len(deviceIDs) == 0 && len(serviceTags) == 0) {
+		return diags
+	}
+	report := diags.AddError
+	if guard.Policy == clients.FirmwareGuardPolicyWarning {
+		report = diags.AddWarning
+	}
+
+	devices, err := omeClient.GetDevices(serviceTags, deviceIDs, nil)
+	if err != nil {
+		report(clients.ErrFirmwareComplianceGuardCheck, err.Error())
+		return diags
+	}
+	_, uniqueDeviceIDs, _ := omeClient.GetUniqueDevicesIdsAndServiceTags(devices)
+	baselines, err := omeClient.GetComplianceReportDetails(uniqueDeviceIDs)
+	if err != nil {
+		report(clients.ErrFirmwareComplianceGuardCheck, err.Error())
+		return diags
+	}
+	clients.ApplyDeviceFirmwareComponentRules(baselines, guard.ComponentRules)
+	violations := clients.FirmwareComplianceViolations(baselines, guard.Severity, guard.BaselineNames)
+	tflog.Debug(ctx, "firmware compliance guard", map[string]interface{}{
+		"devices":    uniqueDeviceIDs,
+		"violations": len(violations),
+	})
+	if len(violations) > 0 {
+		report(fmt.Sprintf(clients.ErrFirmwareComplianceGuard, guard.Severity), clients.FirmwareComplianceViolationsDetail(violations))
+	}
+	return diags
+}
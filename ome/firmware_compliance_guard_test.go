@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestFirmwareComplianceGuard(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+	critical := []models.FirmwareBaselinesGetModel{
+		{
+			Name: "guard-baseline",
+			DeviceComplianceReport: []models.DeviceComplianceModel{
+				{
+					ServiceTag:       DeviceSvcTag1,
+					DeviceName:       "guarded-host",
+					ComplianceStatus: string(models.CRITICAL),
+					ComponentComplianceReports: []models.ComponentComplianceReportModel{
+						{Name: "BIOS", SourceName: "DCIM:INSTALLED#741__BIOS.Setup.1-1", CurrentVersion: "2.9.1", Version: "2.19.1", ComplianceStatus: string(models.CRITICAL)},
+					},
+				},
+			},
+		},
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testFirmwareComplianceGuard(`severity = "OK"`),
+				ExpectError: regexp.MustCompile(`.*value must be one of.*`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock((*clients.Client).GetComplianceReportDetails).Return(critical, nil).Build()
+				},
+				Config:      testFirmwareComplianceGuard(`severity = "CRITICAL"`),
+				ExpectError: regexp.MustCompile(`(?s).*CRITICAL or worse out of firmware compliance.*BIOS 2.9.1 -> 2.19.1.*`),
+			},
+			// the warning policy lets the plan through
+			{
+				Config:             testFirmwareComplianceGuard(`severity = "WARNING"` + "\n" + `policy = "warning"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// the guard only trips at the severity or worse
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					critical[0].DeviceComplianceReport[0].ComponentComplianceReports[0].ComplianceStatus = string(models.DOWNGRADE)
+					critical[0].DeviceComplianceReport[0].ComplianceStatus = string(models.DOWNGRADE)
+					FunctionMocker = Mock((*clients.Client).GetComplianceReportDetails).Return(critical, nil).Build()
+				},
+				Config:             testFirmwareComplianceGuard(`severity = "WARNING"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// the component rules of the baseline are applied before the guard is checked
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					critical[0].DeviceComplianceReport[0].ComponentComplianceReports[0].ComplianceStatus = string(models.CRITICAL)
+					critical[0].DeviceComplianceReport[0].ComplianceStatus = string(models.CRITICAL)
+					FunctionMocker = Mock((*clients.Client).GetComplianceReportDetails).Return(critical, nil).Build()
+				},
+				Config: testFirmwareComplianceGuard(`severity = "CRITICAL"
+					baseline_component_rules = [
+						{
+							baseline_name = "guard-baseline"
+							component_rules = [{ action = "Exclude", source_name = "DCIM:INSTALLED#741__BIOS.*" }]
+						}
+					]`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock((*clients.Client).GetComplianceReportDetails).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      testFirmwareComplianceGuard(`severity = "CRITICAL"`),
+				ExpectError: regexp.MustCompile(`.*Mock error*.`),
+			},
+		},
+	})
+}
+
+func testFirmwareComplianceGuard(guard string) string {
+	return `
+	provider "ome" {
+		username = "` + omeUserName + `"
+		password = "` + omePassword + `"
+		host = "` + omeHost + `"
+		port = "` + port + `"
+		protocol = "` + protocol + `"
+		skipssl = true
+		firmware_compliance_guard = {
+			` + guard + `
+		}
+	}
+
+	resource "ome_configuration_compliance" "guarded" {
+		baseline_name = "` + BaselineName + `"
+		target_devices = [
+			{
+				device_service_tag = "` + DeviceSvcTag1 + `"
+				compliance_status = "Compliant"
+			},
+		]
+	}
+`
+}
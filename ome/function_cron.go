@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &cronFunction{}
+
+// NewCronFunction is a new function to build OME cron expressions
+func NewCronFunction() function.Function {
+	return &cronFunction{}
+}
+
+type cronFunction struct{}
+
+// Metadata implements function.Function
+func (f *cronFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron"
+}
+
+// Definition implements function.Function
+func (f *cronFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the 7-field cron expression used by OME.",
+		MarkdownDescription: "Builds the 7-field cron expression used by OME from a map of its fields." +
+			" Supported fields are `second`, `minute`, `hour`, `day_of_month`, `month`, `day_of_week` and `year`." +
+			" Unset time fields finer than the coarsest time field set default to `0`, the other unset fields default to `*`." +
+			" Only one of `day_of_month` and `day_of_week` can be set, the other one is set to `?`, or to `*` when the set one is `?`." +
+			" Every field is validated, so invalid schedules are reported at plan time.",
+		Description: "Builds the 7-field cron expression used by OME from a map of its fields." +
+			" Supported fields are 'second', 'minute', 'hour', 'day_of_month', 'month', 'day_of_week' and 'year'." +
+			" Unset time fields finer than the coarsest time field set default to '0', the other unset fields default to '*'." +
+			" Only one of 'day_of_month' and 'day_of_week' can be set, the other one is set to '?', or to '*' when the set one is '?'." +
+			" Every field is validated, so invalid schedules are reported at plan time.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:        "schedule",
+				Description: "Fields of the cron expression, for example { hour = \"2\", day_of_week = \"SUN\" }.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run implements function.Function
+func (f *cronFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schedule map[string]string
+	resp.Error = req.Arguments.Get(ctx, &schedule)
+	if resp.Error != nil {
+		return
+	}
+	cron, err := utils.BuildCron(schedule)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, cron)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &fqddParseFunction{}
+
+var fqddParseReturnTypes = map[string]attr.Type{
+	"component": types.StringType,
+	"fqdd":      types.StringType,
+	"groups":    types.ListType{ElemType: types.StringType},
+	"attribute": types.StringType,
+}
+
+// NewFQDDParseFunction is a new function to parse the display name of template attributes
+func NewFQDDParseFunction() function.Function {
+	return &fqddParseFunction{}
+}
+
+type fqddParseFunction struct{}
+
+// Metadata implements function.Function
+func (f *fqddParseFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "fqdd_parse"
+}
+
+// Definition implements function.Function
+func (f *fqddParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses the display name of a template attribute.",
+		MarkdownDescription: "Parses the comma separated display name of a template attribute," +
+			" for example `NIC,NIC.Integrated.1-1-1,iSCSI General Parameters,Boot to Target`." +
+			" The returned object holds the `component` (`NIC`), the `fqdd` of the device (`NIC.Integrated.1-1-1`, null when there is none)," +
+			" the attribute `groups` below the component and the `attribute` name (`Boot to Target`).",
+		Description: "Parses the comma separated display name of a template attribute," +
+			" for example 'NIC,NIC.Integrated.1-1-1,iSCSI General Parameters,Boot to Target'." +
+			" The returned object holds the 'component' ('NIC'), the 'fqdd' of the device ('NIC.Integrated.1-1-1', null when there is none)," +
+			" the attribute 'groups' below the component and the 'attribute' name ('Boot to Target').",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "display_name",
+				Description: "Display name of the template attribute.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: fqddParseReturnTypes,
+		},
+	}
+}
+
+// Run implements function.Function
+func (f *fqddParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var displayName string
+	resp.Error = req.Arguments.Get(ctx, &displayName)
+	if resp.Error != nil {
+		return
+	}
+	parsed, err := utils.ParseAttributeDisplayName(displayName)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	fqdd := types.StringNull()
+	if parsed.FQDD != "" {
+		fqdd = types.StringValue(parsed.FQDD)
+	}
+	result, diags := types.ObjectValue(fqddParseReturnTypes, map[string]attr.Value{
+		"component": types.StringValue(parsed.Component),
+		"fqdd":      fqdd,
+		"groups":    utils.ConvertStringListValue(parsed.Groups),
+		"attribute": types.StringValue(parsed.Attribute),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
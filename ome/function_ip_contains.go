@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"terraform-provider-ome/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &ipContainsFunction{}
+
+// NewIPContainsFunction is a new function to check if an IP belongs to a set of IP ranges
+func NewIPContainsFunction() function.Function {
+	return &ipContainsFunction{}
+}
+
+type ipContainsFunction struct{}
+
+// Metadata implements function.Function
+func (f *ipContainsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ip_contains"
+}
+
+// Definition implements function.Function
+func (f *ipContainsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Checks if an IP address belongs to a set of IP ranges.",
+		MarkdownDescription: "Checks if an IP address belongs to a set of IPs, IP ranges such as `10.36.0.1-10.36.0.20` and CIDRs such as `10.36.0.0/28`." +
+			" The set uses the same format as the `ip_expressions` filter of the `ome_device` data source.",
+		Description: "Checks if an IP address belongs to a set of IPs, IP ranges such as '10.36.0.1-10.36.0.20' and CIDRs such as '10.36.0.0/28'." +
+			" The set uses the same format as the 'ip_expressions' filter of the 'ome_device' data source.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "set",
+				Description: "IPs, IP ranges and CIDRs of the set.",
+				ElementType: types.StringType,
+			},
+			function.StringParameter{
+				Name:        "ip",
+				Description: "IP address to look for.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run implements function.Function
+func (f *ipContainsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var networks []string
+	var address string
+	resp.Error = req.Arguments.Get(ctx, &networks, &address)
+	if resp.Error != nil {
+		return
+	}
+	set, err := utils.ParseNetworks(networks)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	ip := net.ParseIP(address)
+	if ip == nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("%s is not a valid IP address", address))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, set.Contains(ip))
+}
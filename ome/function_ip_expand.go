@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-ome/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// ipExpandLimit - maximum number of addresses returned by ip_expand
+	ipExpandLimit = 65536
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &ipExpandFunction{}
+
+// NewIPExpandFunction is a new function to list the addresses of an IP range
+func NewIPExpandFunction() function.Function {
+	return &ipExpandFunction{}
+}
+
+type ipExpandFunction struct{}
+
+// Metadata implements function.Function
+func (f *ipExpandFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ip_expand"
+}
+
+// Definition implements function.Function
+func (f *ipExpandFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Lists the IP addresses of an IP range.",
+		MarkdownDescription: "Lists the IP addresses of a single IP, an IP range such as `10.36.0.1-10.36.0.20` or a CIDR such as `10.36.0.0/28`." +
+			fmt.Sprintf(" The range must not hold more than `%d` addresses.", ipExpandLimit),
+		Description: "Lists the IP addresses of a single IP, an IP range such as '10.36.0.1-10.36.0.20' or a CIDR such as '10.36.0.0/28'." +
+			fmt.Sprintf(" The range must not hold more than '%d' addresses.", ipExpandLimit),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "range",
+				Description: "IP, IP range or CIDR to expand.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run implements function.Function
+func (f *ipExpandFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var network string
+	resp.Error = req.Arguments.Get(ctx, &network)
+	if resp.Error != nil {
+		return
+	}
+	addresses, err := utils.ExpandNetwork(network, ipExpandLimit)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, addresses)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func runFunction(f function.Function, args ...attr.Value) function.RunResponse {
+	ctx := context.Background()
+	var def function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &def)
+	result, _ := def.Definition.Return.NewResultData(ctx)
+	resp := function.RunResponse{Result: result}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+	return resp
+}
+
+func TestFunctionDefinitions(t *testing.T) {
+	ctx := context.Background()
+	for _, newFunction := range New().(*omeProvider).Functions(ctx) {
+		var resp function.DefinitionResponse
+		newFunction().Definition(ctx, function.DefinitionRequest{}, &resp)
+		var validate function.DefinitionValidateResponse
+		resp.Definition.ValidateImplementation(ctx, function.DefinitionValidateRequest{FuncName: "test"}, &validate)
+		assert.False(t, validate.Diagnostics.HasError())
+	}
+}
+
+func TestCronFunction(t *testing.T) {
+	schedule, _ := types.MapValueFrom(context.Background(), types.StringType, map[string]string{
+		"hour":        "2",
+		"day_of_week": "SUN",
+	})
+	resp := runFunction(NewCronFunction(), schedule)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.StringValue("0 0 2 ? * SUN *"), resp.Result.Value())
+
+	schedule, _ = types.MapValueFrom(context.Background(), types.StringType, map[string]string{"hour": "25"})
+	resp = runFunction(NewCronFunction(), schedule)
+	assert.NotNil(t, resp.Error)
+}
+
+func TestIPExpandFunction(t *testing.T) {
+	resp := runFunction(NewIPExpandFunction(), types.StringValue("10.36.0.1-10.36.0.3"))
+	assert.Nil(t, resp.Error)
+	expected, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"10.36.0.1", "10.36.0.2", "10.36.0.3"})
+	assert.Equal(t, expected, resp.Result.Value())
+
+	resp = runFunction(NewIPExpandFunction(), types.StringValue("10.0.0.0/8"))
+	assert.NotNil(t, resp.Error)
+}
+
+func TestIPContainsFunction(t *testing.T) {
+	set, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"10.36.0.0/24", "192.35.0.1-192.35.0.10"})
+	resp := runFunction(NewIPContainsFunction(), set, types.StringValue("192.35.0.5"))
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.BoolValue(true), resp.Result.Value())
+
+	resp = runFunction(NewIPContainsFunction(), set, types.StringValue("10.36.1.5"))
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.BoolValue(false), resp.Result.Value())
+
+	resp = runFunction(NewIPContainsFunction(), set, types.StringValue("hallo"))
+	assert.NotNil(t, resp.Error)
+}
+
+func TestFQDDParseFunction(t *testing.T) {
+	resp := runFunction(NewFQDDParseFunction(), types.StringValue("NIC,NIC.Integrated.1-1-1,iSCSI General Parameters,Boot to Target"))
+	assert.Nil(t, resp.Error)
+	result := resp.Result.Value().(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("NIC"), result["component"])
+	assert.Equal(t, types.StringValue("NIC.Integrated.1-1-1"), result["fqdd"])
+	assert.Equal(t, types.StringValue("Boot to Target"), result["attribute"])
+
+	resp = runFunction(NewFQDDParseFunction(), types.StringValue("iDRAC,Users,User 2,User Name"))
+	assert.Nil(t, resp.Error)
+	assert.True(t, resp.Result.Value().(types.Object).Attributes()["fqdd"].IsNull())
+
+	resp = runFunction(NewFQDDParseFunction(), types.StringValue("BIOS"))
+	assert.NotNil(t, resp.Error)
+}
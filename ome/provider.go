@@ -20,11 +20,15 @@ import (
 	"strconv"
 	"strings"
 	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -41,7 +45,10 @@ const (
 )
 
 var (
-	_ provider.Provider = &omeProvider{}
+	_ provider.Provider                       = &omeProvider{}
+	_ provider.ProviderWithActions            = &omeProvider{}
+	_ provider.ProviderWithFunctions          = &omeProvider{}
+	_ provider.ProviderWithEphemeralResources = &omeProvider{}
 )
 
 // New - returns new provider struct definition.
@@ -60,6 +67,9 @@ type omeProvider struct {
 	// This can be used in Resource and DataSource implementations to verify
 	// that the provider was previously configured.
 	configured bool
+
+	// firmwareGuard is the firmware compliance the targeted devices must meet, nil when the guard is disabled.
+	firmwareGuard *models.FirmwareComplianceGuard
 }
 
 // providerData can be used to store data from the Terraform configuration.
@@ -71,6 +81,8 @@ type providerData struct {
 	SkipSSL  types.Bool   `tfsdk:"skipssl"`
 	Timeout  types.Int64  `tfsdk:"timeout"`
 	Protocol types.String `tfsdk:"protocol"`
+
+	FirmwareComplianceGuard types.Object `tfsdk:"firmware_compliance_guard"`
 }
 
 // Metadata - provider metadata AKA name.
@@ -203,6 +215,12 @@ func (p *omeProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
+	firmwareGuard, diags := newFirmwareComplianceGuard(ctx, data.FirmwareComplianceGuard)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
 	url := clients.GetURL(https, data.Host.ValueString(), port)
 
 	tflog.Info(ctx, "Collected all data creating client options")
@@ -217,10 +235,13 @@ func (p *omeProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		PreRequestHook: clients.ClientPreReqHook,
 	}
 	p.clientOpt = &clientOptions
+	p.firmwareGuard = firmwareGuard
 
 	p.configured = true
 	resp.DataSourceData = p
 	resp.ResourceData = p
+	resp.ActionData = p
+	resp.EphemeralResourceData = p
 
 	tflog.Trace(ctx, p.clientOpt.Username)
 	tflog.Trace(ctx, "Finished configuring the provider")
@@ -285,6 +306,37 @@ func (p *omeProvider) DataSources(ctx context.Context) []func() datasource.DataS
 		NewDeviceComplianceReportDataSource,
 		NewFabricDataSource,
 		NewUplinkDataSource,
+		NewTemplateExportDataSource,
+		NewTemplateDiffDataSource,
+		NewDeploymentPrecheckDataSource,
+		NewDeviceIdentitiesDataSource,
+		NewCatalogContentsDataSource,
+		NewFirmwareComplianceSummaryDataSource,
+	}
+}
+
+func (p *omeProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewSessionEphemeralResource,
+	}
+}
+
+func (p *omeProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewDeviceInventoryRefreshAction,
+		NewDevicePowerAction,
+		NewIDRACResetAction,
+		NewComplianceCheckAction,
+		NewFirmwareCatalogRefreshAction,
+	}
+}
+
+func (p *omeProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewCronFunction,
+		NewIPExpandFunction,
+		NewIPContainsFunction,
+		NewFQDDParseFunction,
 	}
 }
 
@@ -349,6 +401,7 @@ func (p *omeProvider) Schema(ctx context.Context, _ provider.SchemaRequest, resp
 					}...),
 				},
 			},
+			"firmware_compliance_guard": firmwareComplianceGuardSchema(),
 		},
 	}
 }
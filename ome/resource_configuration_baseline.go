@@ -17,14 +17,17 @@ import (
 	"context"
 	"fmt"
 	"net/mail"
+	"slices"
 	"strings"
 	"terraform-provider-ome/clients"
 	"terraform-provider-ome/models"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -110,20 +113,54 @@ func (r resourceConfigurationBaseline) Schema(_ context.Context, _ resource.Sche
 			},
 			"device_ids": schema.SetAttribute{
 				MarkdownDescription: "List of the device id on which the baseline compliance needs to be run." +
-					" Conflicts with `device_servicetags`.",
+					" Conflicts with `device_servicetags`, `group_names` and `group_ids`.",
 				Description: "List of the device id on which the baseline compliance needs to be run." +
-					" Conflicts with 'device_servicetags'.",
+					" Conflicts with 'device_servicetags', 'group_names' and 'group_ids'.",
 				ElementType: types.Int64Type,
 				Optional:    true,
 			},
 			"device_servicetags": schema.SetAttribute{
 				MarkdownDescription: "List of the device servicetag on which the baseline compliance needs to be run." +
-					" Conflicts with `device_ids`.",
+					" Conflicts with `device_ids`, `group_names` and `group_ids`.",
 				Description: "List of the device servicetag on which the baseline compliance needs to be run." +
-					" Conflicts with 'device_ids'.",
+					" Conflicts with 'device_ids', 'group_names' and 'group_ids'.",
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"group_names": schema.SetAttribute{
+				MarkdownDescription: "List of the device group names on which the baseline compliance needs to be run." +
+					" The subgroups of the given groups are targeted as well." +
+					" Conflicts with `device_ids`, `device_servicetags` and `group_ids`.",
+				Description: "List of the device group names on which the baseline compliance needs to be run." +
+					" The subgroups of the given groups are targeted as well." +
+					" Conflicts with 'device_ids', 'device_servicetags' and 'group_ids'.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ConflictsWith(
+						path.MatchRoot("device_ids"),
+						path.MatchRoot("device_servicetags"),
+						path.MatchRoot("group_ids"),
+					),
+				},
+			},
+			"group_ids": schema.SetAttribute{
+				MarkdownDescription: "List of the device group ids on which the baseline compliance needs to be run." +
+					" Conflicts with `device_ids`, `device_servicetags` and `group_names`.",
+				Description: "List of the device group ids on which the baseline compliance needs to be run." +
+					" Conflicts with 'device_ids', 'device_servicetags' and 'group_names'.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ConflictsWith(
+						path.MatchRoot("device_ids"),
+						path.MatchRoot("device_servicetags"),
+						path.MatchRoot("group_names"),
+					),
+				},
+			},
 			"schedule": schema.BoolAttribute{
 				MarkdownDescription: "Schedule notification via email." +
 					" Default value is `false`.",
@@ -247,6 +284,8 @@ func (r resourceConfigurationBaseline) Create(ctx context.Context, req resource.
 
 	var serviceTags []string
 	var devIDs []int64
+	var groupNames []string
+	var groupIDs []int64
 
 	diags = plan.DeviceServicetags.ElementsAs(ctx, &serviceTags, true)
 	resp.Diagnostics.Append(diags...)
@@ -254,12 +293,18 @@ func (r resourceConfigurationBaseline) Create(ctx context.Context, req resource.
 	diags = plan.DeviceIDs.ElementsAs(ctx, &devIDs, true)
 	resp.Diagnostics.Append(diags...)
 
+	diags = plan.GroupNames.ElementsAs(ctx, &groupNames, true)
+	resp.Diagnostics.Append(diags...)
+
+	diags = plan.GroupIDs.ElementsAs(ctx, &groupIDs, true)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	tflog.Info(ctx, "resource_configuration_baseline create Validating device details")
-	targetDevices, usedDeviceInput, err := getValidTargetDevices(omeClient, serviceTags, devIDs)
+	tflog.Info(ctx, "resource_configuration_baseline create Validating target details")
+	baselineTargets, usedDeviceInput, err := getBaselineTargets(omeClient, serviceTags, devIDs, groupNames, groupIDs)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrGnrCreateBaseline,
@@ -268,7 +313,7 @@ func (r resourceConfigurationBaseline) Create(ctx context.Context, req resource.
 		return
 	}
 
-	cb, err := getPayload(ctx, &plan, omeTemplate.ID, targetDevices)
+	cb, err := getPayload(ctx, &plan, omeTemplate.ID, baselineTargets)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrGnrCreateBaseline, err.Error(),
@@ -317,7 +362,10 @@ func (r resourceConfigurationBaseline) Create(ctx context.Context, req resource.
 	})
 
 	// Save into State
-	updateBaselineState(ctx, &state, &plan, baseline, usedDeviceInput, omeClient)
+	resp.Diagnostics.Append(updateBaselineState(ctx, &state, &plan, baseline, usedDeviceInput, omeClient)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -347,16 +395,28 @@ func (r resourceConfigurationBaseline) Read(ctx context.Context, req resource.Re
 		usedDeviceInput = clients.DeviceIDs
 	} else if len(state.DeviceServicetags.Elements()) > 0 {
 		usedDeviceInput = clients.ServiceTags
+	} else if len(state.GroupNames.Elements()) > 0 {
+		usedDeviceInput = clients.GroupNames
+	} else if len(state.GroupIDs.Elements()) > 0 {
+		usedDeviceInput = clients.GroupIDs
 	}
 	baseline, err := omeClient.GetBaselineByID(state.ID.ValueInt64())
 	if err != nil {
+		if clients.IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Unable to find baseline (%v), clearing state", state.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			clients.ErrGnrReadBaseline, err.Error(),
 		)
 		return
 	}
 
-	updateBaselineState(ctx, &state, &state, baseline, usedDeviceInput, omeClient)
+	resp.Diagnostics.Append(updateBaselineState(ctx, &state, &state, baseline, usedDeviceInput, omeClient)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	//Save into State
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -438,6 +498,8 @@ func (r resourceConfigurationBaseline) Update(ctx context.Context, req resource.
 
 	var serviceTags []string
 	var devIDs []int64
+	var groupNames []string
+	var groupIDs []int64
 
 	diags = plan.DeviceServicetags.ElementsAs(ctx, &serviceTags, true)
 	resp.Diagnostics.Append(diags...)
@@ -445,13 +507,18 @@ func (r resourceConfigurationBaseline) Update(ctx context.Context, req resource.
 	diags = plan.DeviceIDs.ElementsAs(ctx, &devIDs, true)
 	resp.Diagnostics.Append(diags...)
 
+	diags = plan.GroupNames.ElementsAs(ctx, &groupNames, true)
+	resp.Diagnostics.Append(diags...)
+
+	diags = plan.GroupIDs.ElementsAs(ctx, &groupIDs, true)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	tflog.Info(ctx, "resource_configuration_baseline update Validating device details")
-
-	targetDevices, usedDeviceInput, err := getValidTargetDevices(omeClient, serviceTags, devIDs)
+	tflog.Info(ctx, "resource_configuration_baseline update Validating target details")
+	baselineTargets, usedDeviceInput, err := getBaselineTargets(omeClient, serviceTags, devIDs, groupNames, groupIDs)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrGnrUpdateBaseline,
@@ -460,7 +527,7 @@ func (r resourceConfigurationBaseline) Update(ctx context.Context, req resource.
 		return
 	}
 
-	cb, err := getPayload(ctx, &plan, omeTemplate.ID, targetDevices)
+	cb, err := getPayload(ctx, &plan, omeTemplate.ID, baselineTargets)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrGnrUpdateBaseline, err.Error(),
@@ -510,7 +577,10 @@ func (r resourceConfigurationBaseline) Update(ctx context.Context, req resource.
 	})
 
 	// Save into State
-	updateBaselineState(ctx, &state, &plan, baseline, usedDeviceInput, omeClient)
+	resp.Diagnostics.Append(updateBaselineState(ctx, &state, &plan, baseline, usedDeviceInput, omeClient)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	//Save into State
 	diags = resp.State.Set(ctx, &state)
@@ -564,7 +634,14 @@ func (r resourceConfigurationBaseline) ImportState(ctx context.Context, req reso
 		resp.Diagnostics.AddError(clients.ErrImportDeployment, err.Error())
 		return
 	}
-	dia := updateBaselineState(ctx, &state, &state, baseline, clients.ServiceTags, omeClient)
+	usedDeviceInput := clients.ServiceTags
+	for _, bTarget := range baseline.BaselineTargets {
+		if bTarget.Type.Name == "GROUP" {
+			usedDeviceInput = clients.GroupNames
+			break
+		}
+	}
+	dia := updateBaselineState(ctx, &state, &state, baseline, usedDeviceInput, omeClient)
 	resp.Diagnostics.Append(dia...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -576,6 +653,12 @@ func (r resourceConfigurationBaseline) ImportState(ctx context.Context, req reso
 	if len(state.DeviceIDs.Elements()) == 0 {
 		state.DeviceIDs, _ = types.SetValue(types.Int64Type, nil)
 	}
+	if usedDeviceInput == clients.GroupNames {
+		state.DeviceServicetags = types.SetNull(types.StringType)
+	} else {
+		state.GroupNames = types.SetNull(types.StringType)
+	}
+	state.GroupIDs = types.SetNull(types.Int64Type)
 	state.OutputFormat = types.StringValue("html")
 	state.JobRetryCount = types.Int64Value(30)
 	state.SleepInterval = types.Int64Value(20)
@@ -632,7 +715,41 @@ func updateBaselineState(ctx context.Context, state *models.ConfigureBaselines,
 	state.Description = types.StringValue(omeBaseline.Description)
 	state.BaselineName = types.StringValue(omeBaseline.Name)
 
-	if usedDeviceInput == clients.ServiceTags {
+	switch usedDeviceInput {
+	case clients.GroupNames, clients.GroupIDs:
+		targetGroups, err := getTargetGroups(omeClient, omeBaseline)
+		if err != nil {
+			dia.AddError(clients.ErrGnrReadBaseline, err.Error())
+			return dia
+		}
+		if usedDeviceInput == clients.GroupNames {
+			planNames := []string{}
+			plan.GroupNames.ElementsAs(ctx, &planNames, true)
+			groupNameVals := []attr.Value{}
+			for _, name := range baselineGroupNames(targetGroups, planNames) {
+				groupNameVals = append(groupNameVals, types.StringValue(name))
+			}
+			groupNamesTfsdk, _ := types.SetValue(types.StringType, groupNameVals)
+			state.GroupNames = groupNamesTfsdk
+			state.GroupIDs = plan.GroupIDs
+		} else {
+			groupIDVals := []attr.Value{}
+			for _, group := range targetGroups {
+				groupIDVals = append(groupIDVals, types.Int64Value(group.ID))
+			}
+			groupIDsTfsdk, _ := types.SetValue(types.Int64Type, groupIDVals)
+			state.GroupIDs = groupIDsTfsdk
+			state.GroupNames = plan.GroupNames
+		}
+		// devices assigned to the baseline outside of Terraform are reported as a change of device_ids
+		state.DeviceIDs = plan.DeviceIDs
+		if deviceIDs := baselineDeviceTargetIDs(omeBaseline); len(deviceIDs) > 0 {
+			deviceIDsTfsdk, d := types.SetValueFrom(ctx, types.Int64Type, deviceIDs)
+			dia.Append(d...)
+			state.DeviceIDs = deviceIDsTfsdk
+		}
+		state.DeviceServicetags = plan.DeviceServicetags
+	case clients.ServiceTags:
 		apiDeviceIDs := map[string]models.Device{}
 		devSts := []string{}
 		deviceStVals := []attr.Value{}
@@ -664,7 +781,9 @@ func updateBaselineState(ctx context.Context, state *models.ConfigureBaselines,
 		)
 		state.DeviceServicetags = devSTsTfsdk
 		state.DeviceIDs = plan.DeviceIDs
-	} else {
+		state.GroupNames = plan.GroupNames
+		state.GroupIDs = plan.GroupIDs
+	default:
 		apiDeviceIDs := map[int64]models.Device{}
 		devIDs := []int64{}
 		deviceIDVals := []attr.Value{}
@@ -691,6 +810,8 @@ func updateBaselineState(ctx context.Context, state *models.ConfigureBaselines,
 		devIDsTfsdk, _ := types.SetValue(types.Int64Type, deviceIDVals)
 		state.DeviceIDs = devIDsTfsdk
 		state.DeviceServicetags = plan.DeviceServicetags
+		state.GroupNames = plan.GroupNames
+		state.GroupIDs = plan.GroupIDs
 	}
 
 	notificationSettings := omeBaseline.NotificationSettings
@@ -732,25 +853,13 @@ func updateBaselineState(ctx context.Context, state *models.ConfigureBaselines,
 	return
 }
 
-func getPayload(ctx context.Context, plan *models.ConfigureBaselines, templateID int64, targetDevices []models.Device) (models.ConfigurationBaselinePayload, error) {
+func getPayload(ctx context.Context, plan *models.ConfigureBaselines, templateID int64, baselineTargets []models.BaselineTarget) (models.ConfigurationBaselinePayload, error) {
 	cbp := models.ConfigurationBaselinePayload{
-		Name:        plan.BaselineName.ValueString(),
-		Description: plan.Description.ValueString(),
-		TemplateID:  templateID,
-	}
-
-	var baselineTargets []models.BaselineTarget
-	for _, tDevices := range targetDevices {
-		baselineTarget := models.BaselineTarget{
-			ID: tDevices.ID,
-			Type: models.BaselineTargetType{
-				ID:   1,
-				Name: "DEVICE",
-			},
-		}
-		baselineTargets = append(baselineTargets, baselineTarget)
+		Name:            plan.BaselineName.ValueString(),
+		Description:     plan.Description.ValueString(),
+		TemplateID:      templateID,
+		BaselineTargets: baselineTargets,
 	}
-	cbp.BaselineTargets = baselineTargets
 
 	if plan.Schedule.ValueBool() {
 		if len(plan.EmailAddresses.Elements()) == 0 {
@@ -819,6 +928,127 @@ func getValidTargetDevices(omeClient *clients.Client, serviceTags []string, devI
 	return targetDevices, usedDeviceInput, err
 }
 
+// getBaselineTargets returns the baseline targets for either the given devices or the given device groups
+func getBaselineTargets(omeClient *clients.Client, serviceTags []string, devIDs []int64, groupNames []string, groupIDs []int64) ([]models.BaselineTarget, string, error) {
+	var baselineTargets []models.BaselineTarget
+	if len(groupNames) == 0 && len(groupIDs) == 0 {
+		targetDevices, usedDeviceInput, err := getValidTargetDevices(omeClient, serviceTags, devIDs)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, tDevices := range targetDevices {
+			baselineTargets = append(baselineTargets, models.BaselineTarget{
+				ID: tDevices.ID,
+				Type: models.BaselineTargetType{
+					ID:   1,
+					Name: "DEVICE",
+				},
+			})
+		}
+		return baselineTargets, usedDeviceInput, nil
+	}
+
+	targetGroups, usedGroupInput, err := getValidTargetGroups(omeClient, groupNames, groupIDs)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, group := range targetGroups {
+		baselineTargets = append(baselineTargets, models.BaselineTarget{
+			ID: group.ID,
+			Type: models.BaselineTargetType{
+				ID:   group.TypeID,
+				Name: "GROUP",
+			},
+		})
+	}
+	return baselineTargets, usedGroupInput, nil
+}
+
+// getValidTargetGroups resolves the groups by name, including their subgroups, or by id
+func getValidTargetGroups(omeClient *clients.Client, groupNames []string, groupIDs []int64) ([]models.Group, string, error) {
+	if len(groupNames) > 0 {
+		groups, err := omeClient.GetValidGroupsByNames(groupNames)
+		if err != nil {
+			return []models.Group{}, "", err
+		}
+		var invalidGroups []string
+		for _, name := range groupNames {
+			found := false
+			for _, group := range groups {
+				if group.Name == name {
+					found = true
+					break
+				}
+			}
+			if !found {
+				invalidGroups = append(invalidGroups, name)
+			}
+		}
+		if len(invalidGroups) != 0 {
+			return []models.Group{}, "", fmt.Errorf(clients.ErrBaseLineInvalidGroups, invalidGroups)
+		}
+		return groups, clients.GroupNames, nil
+	}
+
+	var groups []models.Group
+	for _, groupID := range groupIDs {
+		group, err := omeClient.GetGroupByID(groupID)
+		if err != nil {
+			return []models.Group{}, "", fmt.Errorf(clients.ErrBaseLineInvalidGroups, []int64{groupID})
+		}
+		groups = append(groups, group)
+	}
+	return groups, clients.GroupIDs, nil
+}
+
+// getTargetGroups fetches the device groups the baseline is currently assigned to.
+// The groups deleted from OME are left out so that they show up as a change of the groups.
+func getTargetGroups(omeClient *clients.Client, omeBaseline models.OmeBaseline) ([]models.Group, error) {
+	var groups []models.Group
+	for _, bTarget := range omeBaseline.BaselineTargets {
+		if bTarget.Type.Name != "GROUP" {
+			continue
+		}
+		group, err := omeClient.GetGroupByID(bTarget.ID)
+		if clients.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the group %d targeted by the baseline: %w", bTarget.ID, err)
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// baselineDeviceTargetIDs returns the ids of the devices the baseline is assigned to directly, not through a group
+func baselineDeviceTargetIDs(omeBaseline models.OmeBaseline) []int64 {
+	deviceIDs := []int64{}
+	for _, bTarget := range omeBaseline.BaselineTargets {
+		if bTarget.Type.Name != "GROUP" {
+			deviceIDs = append(deviceIDs, bTarget.ID)
+		}
+	}
+	return deviceIDs
+}
+
+// baselineGroupNames returns the names of the assigned groups to be kept in state.
+// Subgroups that were added through their parent group are left out unless they were configured explicitly.
+func baselineGroupNames(targetGroups []models.Group, planNames []string) []string {
+	targetIDs := map[int64]bool{}
+	for _, group := range targetGroups {
+		targetIDs[group.ID] = true
+	}
+	names := []string{}
+	for _, group := range targetGroups {
+		if group.Name == "" || (targetIDs[group.ParentID] && !slices.Contains(planNames, group.Name)) {
+			continue
+		}
+		names = append(names, group.Name)
+	}
+	return names
+}
+
 func validateNotification(plan models.ConfigureBaselines) error {
 	if !plan.Schedule.ValueBool() {
 		if !plan.Cron.IsNull() || !plan.EmailAddresses.IsNull() {
@@ -26,6 +26,7 @@ import (
 	. "github.com/bytedance/mockey"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -905,3 +906,83 @@ var testUpdateBaselinewithInvalidSchedule = `
 		schedule=true
 	}
 `
+
+func TestCreateBaseline_BaselineWithGroups(t *testing.T) {
+	if os.Getenv("TF_ACC") == "0" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+	temps := initTemplates(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfigureBaselinewithGroupAndDevice + temps.templateSvcTag1,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config:      testConfigureBaselinewithInvalidGroup + temps.templateSvcTag1,
+				ExpectError: regexp.MustCompile(".*could not be found.*"),
+			},
+			{
+				Config: testConfigureBaselinewithGroupName + temps.templateSvcTag1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_configuration_baseline.create_baseline", "baseline_name", BaselineName+"-group"),
+					resource.TestCheckResourceAttr("ome_configuration_baseline.create_baseline", "group_names.#", "1"),
+					resource.TestCheckResourceAttr("ome_configuration_baseline.create_baseline", "group_names.0", "Servers")),
+			},
+		},
+	})
+}
+
+func TestBaselineGroupNames(t *testing.T) {
+	targetGroups := []models.Group{
+		{ID: 1, Name: "Servers"},
+		{ID: 2, Name: "Linux Servers", ParentID: 1},
+		{ID: 3, Name: "Chassis"},
+		{ID: 4},
+	}
+	assert.ElementsMatch(t, []string{"Servers", "Chassis"}, baselineGroupNames(targetGroups, []string{"Servers"}))
+	assert.ElementsMatch(t, []string{"Servers", "Linux Servers", "Chassis"}, baselineGroupNames(targetGroups, []string{"Servers", "Linux Servers"}))
+	assert.ElementsMatch(t, []string{"Linux Servers"}, baselineGroupNames(targetGroups[1:2], []string{"Servers"}))
+	assert.Empty(t, baselineGroupNames(nil, []string{"Servers"}))
+}
+
+func TestBaselineDeviceTargetIDs(t *testing.T) {
+	baseline := models.OmeBaseline{BaselineTargets: []models.BaselineTarget{
+		{ID: 1011, Type: models.BaselineTargetType{ID: 6000, Name: "GROUP"}},
+		{ID: 10339, Type: models.BaselineTargetType{ID: 1000, Name: "DEVICE"}},
+	}}
+	assert.Equal(t, []int64{10339}, baselineDeviceTargetIDs(baseline))
+	assert.Empty(t, baselineDeviceTargetIDs(models.OmeBaseline{}))
+}
+
+var testConfigureBaselinewithGroupAndDevice = justProvider + `
+	resource "ome_configuration_baseline" "create_baseline" {
+		baseline_name = "` + BaselineName + `-group"
+		ref_template_name = "` + TestRefTemplateName + `"
+		device_servicetags = ["` + DeviceSvcTag1 + `"]
+		group_names = ["Servers"]
+		depends_on = ["ome_template.terraform-acceptance-test-1"]
+	}
+`
+
+var testConfigureBaselinewithInvalidGroup = justProvider + `
+	resource "ome_configuration_baseline" "create_baseline" {
+		baseline_name = "` + BaselineName + `-group"
+		ref_template_name = "` + TestRefTemplateName + `"
+		group_names = ["invalid-group"]
+		depends_on = ["ome_template.terraform-acceptance-test-1"]
+	}
+`
+
+var testConfigureBaselinewithGroupName = justProvider + `
+	resource "ome_configuration_baseline" "create_baseline" {
+		baseline_name = "` + BaselineName + `-group"
+		ref_template_name = "` + TestRefTemplateName + `"
+		group_names = ["Servers"]
+		description = "baseline description"
+		depends_on = ["ome_template.terraform-acceptance-test-1"]
+	}
+`
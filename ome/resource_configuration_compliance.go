@@ -19,10 +19,14 @@ import (
 	"strings"
 	"terraform-provider-ome/clients"
 	"terraform-provider-ome/models"
+	"terraform-provider-ome/utils"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -44,8 +48,9 @@ const (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &resourceConfigurationCompliance{}
-	_ resource.ResourceWithConfigure = &resourceConfigurationCompliance{}
+	_ resource.Resource               = &resourceConfigurationCompliance{}
+	_ resource.ResourceWithConfigure  = &resourceConfigurationCompliance{}
+	_ resource.ResourceWithModifyPlan = &resourceConfigurationCompliance{}
 )
 
 // NewConfigurationComplianceResource is a new resource for configuration compliance
@@ -65,6 +70,36 @@ func (r *resourceConfigurationCompliance) Configure(ctx context.Context, req res
 	r.p = req.ProviderData.(*omeProvider)
 }
 
+// ModifyPlan checks the target devices of a created or updated remediation against the firmware compliance guard of the provider.
+func (r resourceConfigurationCompliance) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.p == nil || r.p.clientOpt == nil || r.p.firmwareGuard == nil || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	var targetDevices types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("target_devices"), &targetDevices)...)
+	if resp.Diagnostics.HasError() || targetDevices.IsUnknown() {
+		return
+	}
+	serviceTags := []attr.Value{}
+	for _, targetDevice := range targetDevices.Elements() {
+		if device, ok := targetDevice.(types.Object); ok && !device.IsUnknown() {
+			serviceTags = append(serviceTags, device.Attributes()["device_service_tag"])
+		}
+	}
+	_, knownServiceTags := firmwareGuardTargets(nil, serviceTags)
+	if len(knownServiceTags) == 0 {
+		return
+	}
+
+	omeClient, d := r.p.createOMESession(ctx, "resource_configuration_compliance ModifyPlan")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+	resp.Diagnostics.Append(r.p.checkFirmwareCompliance(ctx, omeClient, nil, knownServiceTags)...)
+}
+
 // Metadata implements resource.Resource
 func (*resourceConfigurationCompliance) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "configuration_compliance"
@@ -155,6 +190,43 @@ func (r resourceConfigurationCompliance) Schema(_ context.Context, _ resource.Sc
 				Description:         "Cron to schedule the remediation task.",
 				Optional:            true,
 			},
+			"enforce": schema.SingleNestedAttribute{
+				MarkdownDescription: "Enables the enforce mode, in which an apply remediates only the target devices which are not compliant." +
+					" The target devices which drifted from the baseline are reported as changes by the plan." +
+					" Conflicts with `run_later`.",
+				Description: "Enables the enforce mode, in which an apply remediates only the target devices which are not compliant." +
+					" The target devices which drifted from the baseline are reported as changes by the plan." +
+					" Conflicts with 'run_later'.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"max_devices_per_apply": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of devices remediated by an apply, the other non compliant devices are remediated by the next applies.",
+						Description:         "Maximum number of devices remediated by an apply, the other non compliant devices are remediated by the next applies.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"excluded_service_tags": schema.SetAttribute{
+						MarkdownDescription: "Service tags of the target devices which are never remediated, their drift is not reported either.",
+						Description:         "Service tags of the target devices which are never remediated, their drift is not reported either.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"maintenance_window": schema.StringAttribute{
+						MarkdownDescription: "Maintenance window as a 7-field OME cron expression matching the times, in UTC, at which remediations are allowed." +
+							" For example `* * 1-4 ? * SAT,SUN *` allows remediations from 1 AM to 5 AM on weekends." +
+							" An apply outside of the window does not remediate any device.",
+						Description: "Maintenance window as a 7-field OME cron expression matching the times, in UTC, at which remediations are allowed." +
+							" For example '* * 1-4 ? * SAT,SUN *' allows remediations from 1 AM to 5 AM on weekends." +
+							" An apply outside of the window does not remediate any device.",
+						Optional: true,
+						Validators: []validator.String{
+							validCronValidator{},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -177,6 +249,13 @@ func (r resourceConfigurationCompliance) Create(ctx context.Context, req resourc
 		)
 		return
 	}
+	if plan.RunLater.ValueBool() && !plan.Enforce.IsNull() {
+		resp.Diagnostics.AddError(
+			clients.ErrGnrBaseLineCreateRemediation,
+			clients.ErrEnforceRunLater,
+		)
+		return
+	}
 
 	state := models.ConfigurationRemediation{}
 
@@ -213,19 +292,36 @@ func (r resourceConfigurationCompliance) Create(ctx context.Context, req resourc
 	}
 	tflog.Trace(ctx, "resource_configuration_compliance create: all target devices are valid")
 
+	enforce, diags := newConfigurationEnforce(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if enforce != nil {
+		remediateDevices, diags := getEnforcedDevices(ctx, omeClient, baseline.ID, targetDevices, enforce)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		targetDeviceIDs = getDeviceIDs(targetDevices, targetDeviceIDs, remediateDevices)
+	}
+
 	crp := getRemediationPayload(baseline.ID, targetDeviceIDs, plan.RunLater.ValueBool(), plan.Cron.ValueString())
 
 	tflog.Trace(ctx, "resource_configuration_compliance create: triggered remediation", map[string]interface{}{
 		"payload": crp,
 	})
 
-	jobID, err := omeClient.RemediateBaseLineDevices(crp)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			clients.ErrGnrBaseLineCreateRemediation,
-			err.Error(),
-		)
-		return
+	var jobID int64
+	if len(targetDeviceIDs) > 0 {
+		jobID, err = omeClient.RemediateBaseLineDevices(crp)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				clients.ErrGnrBaseLineCreateRemediation,
+				err.Error(),
+			)
+			return
+		}
 	}
 
 	tflog.Trace(ctx, "resource_configuration_compliance create: Job created", map[string]interface{}{
@@ -283,6 +379,11 @@ func (r resourceConfigurationCompliance) Read(ctx context.Context, req resource.
 	//check the compliance status to check if the reports are generated
 	err := checkReportsStatus(omeClient, state.BaselineID.ValueInt64())
 	if err != nil {
+		if clients.IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Unable to find baseline (%v), clearing state", state.BaselineID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			clients.ErrGnrBaseLineReadRemediation,
 			err.Error(),
@@ -292,7 +393,17 @@ func (r resourceConfigurationCompliance) Read(ctx context.Context, req resource.
 
 	tflog.Trace(ctx, "resource_configuration_compliance: read checking status finshed")
 
+	enforce, diags := newConfigurationEnforce(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	for i, td := range state.TargetDevices {
+		// the drift of the devices excluded from the enforce mode is not reported
+		if enforce != nil && utils.ContainsFold(enforce.excluded, td.DeviceServiceTag.ValueString()) {
+			continue
+		}
 		deviceReport, err := omeClient.GetConfiBaselineDeviceReport(state.BaselineID.ValueInt64(), td.DeviceServiceTag.ValueString())
 		if err != nil {
 			if err != nil {
@@ -348,6 +459,13 @@ func (r resourceConfigurationCompliance) Update(ctx context.Context, req resourc
 		)
 		return
 	}
+	if plan.RunLater.ValueBool() && !plan.Enforce.IsNull() {
+		resp.Diagnostics.AddError(
+			clients.ErrBaseLineUpdateRemediation,
+			clients.ErrEnforceRunLater,
+		)
+		return
+	}
 
 	//Create Session and defer the remove session
 	omeClient, d := r.p.createOMESession(ctx, "resource_configuration_compliance: Create")
@@ -393,17 +511,36 @@ func (r resourceConfigurationCompliance) Update(ctx context.Context, req resourc
 	}
 
 	tflog.Trace(ctx, "resource_configuration_compliance: target devices are valid")
+
+	enforce, diags := newConfigurationEnforce(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if enforce != nil {
+		remediateDevices, diags := getEnforcedDevices(ctx, omeClient, baseline.ID, targetDevices, enforce)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		targetDeviceIDs = getDeviceIDs(targetDevices, targetDeviceIDs, remediateDevices)
+	}
+
 	crp := getRemediationPayload(baseline.ID, targetDeviceIDs, plan.RunLater.ValueBool(), plan.Cron.ValueString())
 
 	tflog.Trace(ctx, "resource_configuration_compliance: update remidiation started", map[string]interface{}{
 		"payload": crp,
 	})
-	jobID, err := omeClient.RemediateBaseLineDevices(crp)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			clients.ErrBaseLineUpdateRemediation,
-			err.Error(),
-		)
+	var jobID int64
+	if len(targetDeviceIDs) > 0 {
+		jobID, err = omeClient.RemediateBaseLineDevices(crp)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				clients.ErrBaseLineUpdateRemediation,
+				err.Error(),
+			)
+			return
+		}
 	}
 
 	tflog.Trace(ctx, "resource_configuration_compliance: update remidiation job created", map[string]interface{}{
@@ -447,12 +584,12 @@ func (r resourceConfigurationCompliance) Delete(ctx context.Context, req resourc
 // non compliance devices is handled by remediation API
 func checkValidDevices(omeClient *clients.Client, targetDevices []string, baseline models.OmeBaseline) ([]int64, error) {
 
-	var baselineDevices []int64
 	var targetDeviceIDs []int64
 	deviceIDServiceTagsMap := map[int64]string{}
 
-	for _, bt := range baseline.BaselineTargets {
-		baselineDevices = append(baselineDevices, bt.ID)
+	baselineDevices, err := omeClient.GetBaselineTargetDeviceIDs(baseline)
+	if err != nil {
+		return []int64{}, err
 	}
 
 	for _, st := range targetDevices {
@@ -0,0 +1,159 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"terraform-provider-ome/utils"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ validator.String = &validCronValidator{}
+
+type validCronValidator struct {
+}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v validCronValidator) Description(ctx context.Context) string {
+	return "Value must be a 7-field OME cron expression"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v validCronValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString runs the main validation logic of the validator, reading configuration data out of `req` and updating `resp` with diagnostics.
+func (v validCronValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+	if err := utils.ValidateCron(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, clients.ErrInvalidCron, err.Error())
+	}
+}
+
+// configurationEnforce holds the guard rails of the enforce mode
+type configurationEnforce struct {
+	maxDevices        int64
+	excluded          []string
+	maintenanceWindow string
+}
+
+// newConfigurationEnforce returns the guard rails of the enforce mode, nil when the enforce mode is disabled
+func newConfigurationEnforce(ctx context.Context, plan models.ConfigurationRemediation) (*configurationEnforce, diag.Diagnostics) {
+	if plan.Enforce.IsNull() || plan.Enforce.IsUnknown() {
+		return nil, nil
+	}
+	var enforceModel models.ConfigurationEnforce
+	diags := plan.Enforce.As(ctx, &enforceModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+	enforce := &configurationEnforce{
+		maxDevices:        enforceModel.MaxDevicesPerApply.ValueInt64(),
+		maintenanceWindow: enforceModel.MaintenanceWindow.ValueString(),
+	}
+	diags.Append(enforceModel.ExcludedServiceTags.ElementsAs(ctx, &enforce.excluded, true)...)
+	return enforce, diags
+}
+
+// inMaintenanceWindow reports whether the time, in UTC, is within the maintenance window
+func (e *configurationEnforce) inMaintenanceWindow(t time.Time) (bool, error) {
+	if e.maintenanceWindow == "" {
+		return true, nil
+	}
+	return utils.CronMatches(e.maintenanceWindow, t.UTC())
+}
+
+// selectDevices returns the non compliant devices remediated by this apply and the ones left for the next applies
+func (e *configurationEnforce) selectDevices(nonCompliant []string) ([]string, []string) {
+	remediate := []string{}
+	deferred := []string{}
+	for _, serviceTag := range nonCompliant {
+		if utils.ContainsFold(e.excluded, serviceTag) {
+			continue
+		}
+		if e.maxDevices > 0 && int64(len(remediate)) >= e.maxDevices {
+			deferred = append(deferred, serviceTag)
+			continue
+		}
+		remediate = append(remediate, serviceTag)
+	}
+	return remediate, deferred
+}
+
+// getEnforcedDevices returns the target devices remediated by an apply in enforce mode.
+// Only the non compliant devices are remediated, within the guard rails of the enforce mode.
+func getEnforcedDevices(ctx context.Context, omeClient *clients.Client, baselineID int64, targetDevices []string, enforce *configurationEnforce) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	inWindow, err := enforce.inMaintenanceWindow(time.Now())
+	if err != nil {
+		diags.AddError(clients.ErrInvalidCron, err.Error())
+		return nil, diags
+	}
+	if !inWindow {
+		diags.AddWarning("Configuration remediation skipped",
+			fmt.Sprintf("The current time is outside of the maintenance window %s, the non compliant devices are remediated by the next apply within the window.", enforce.maintenanceWindow))
+		return []string{}, diags
+	}
+
+	if err := checkReportsStatus(omeClient, baselineID); err != nil {
+		diags.AddError(clients.ErrGnrBaseLineReadRemediation, err.Error())
+		return nil, diags
+	}
+	nonCompliant := []string{}
+	for _, serviceTag := range targetDevices {
+		deviceReport, err := omeClient.GetConfiBaselineDeviceReport(baselineID, serviceTag)
+		if err != nil {
+			diags.AddError(clients.ErrGnrBaseLineReadRemediation, err.Error())
+			return nil, diags
+		}
+		if deviceReport.ComplianceStatus != clients.ComplianceStatusCompliant {
+			nonCompliant = append(nonCompliant, serviceTag)
+		}
+	}
+
+	remediate, deferred := enforce.selectDevices(nonCompliant)
+	tflog.Debug(ctx, "resource_configuration_compliance: enforce selected devices", map[string]interface{}{
+		"nonCompliant": nonCompliant,
+		"remediate":    remediate,
+		"deferred":     deferred,
+	})
+	if len(deferred) > 0 {
+		diags.AddWarning("Configuration remediation limited",
+			fmt.Sprintf("Only %d non compliant devices are remediated by an apply, the devices %s are remediated by the next apply.", enforce.maxDevices, strings.Join(deferred, ", ")))
+	}
+	return remediate, diags
+}
+
+// getDeviceIDs returns the IDs of the given service tags among the validated target devices
+func getDeviceIDs(targetDevices []string, targetDeviceIDs []int64, serviceTags []string) []int64 {
+	deviceIDs := []int64{}
+	for i, targetDevice := range targetDevices {
+		if utils.ContainsFold(serviceTags, targetDevice) {
+			deviceIDs = append(deviceIDs, targetDeviceIDs[i])
+		}
+	}
+	return deviceIDs
+}
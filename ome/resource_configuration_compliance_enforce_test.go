@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/models"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigurationEnforce(t *testing.T) {
+	ctx := context.Background()
+	enforce, diags := newConfigurationEnforce(ctx, models.ConfigurationRemediation{Enforce: types.ObjectNull(map[string]attr.Type{})})
+	assert.False(t, diags.HasError())
+	assert.Nil(t, enforce)
+
+	attrTypes := map[string]attr.Type{
+		"max_devices_per_apply": types.Int64Type,
+		"excluded_service_tags": types.SetType{ElemType: types.StringType},
+		"maintenance_window":    types.StringType,
+	}
+	plan := models.ConfigurationRemediation{
+		Enforce: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"max_devices_per_apply": types.Int64Value(2),
+			"excluded_service_tags": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("SVC0002")}),
+			"maintenance_window":    types.StringValue("* * 1-4 ? * SAT,SUN *"),
+		}),
+	}
+	enforce, diags = newConfigurationEnforce(ctx, plan)
+	assert.False(t, diags.HasError())
+
+	remediate, deferred := enforce.selectDevices([]string{"SVC0001", "svc0002", "SVC0003", "SVC0004"})
+	assert.Equal(t, []string{"SVC0001", "SVC0003"}, remediate)
+	assert.Equal(t, []string{"SVC0004"}, deferred)
+
+	inWindow, err := enforce.inMaintenanceWindow(time.Date(2026, time.October, 17, 2, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.True(t, inWindow)
+	inWindow, err = enforce.inMaintenanceWindow(time.Date(2026, time.October, 19, 2, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.False(t, inWindow)
+
+	assert.Equal(t, []int64{11, 13}, getDeviceIDs([]string{"SVC0001", "SVC0002", "SVC0003"}, []int64{11, 12, 13}, remediate))
+}
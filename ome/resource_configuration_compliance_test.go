@@ -90,6 +90,10 @@ func TestConfigurationRemediationInvScenarios(t *testing.T) {
 				Config:      testConfigureBaselineRemediationBaselineInfo,
 				ExpectError: regexp.MustCompile(clients.ErrGnrBaseLineCreateRemediation),
 			},
+			{ //  invalid maintenance window of the enforce mode
+				Config:      testConfigureBaselineRemediationInvalidMaintenanceWindow,
+				ExpectError: regexp.MustCompile(clients.ErrInvalidCron),
+			},
 		},
 	})
 
@@ -123,6 +127,31 @@ var testConfigureBaselineRemediationDevicePartOfBaseline = `
 	}
 `
 
+var testConfigureBaselineRemediationInvalidMaintenanceWindow = `
+	provider "ome" {
+		username = "` + omeUserName + `"
+		password = "` + omePassword + `"
+		host = "` + omeHost + `"
+		port = "` + port + `"
+        protocol = "` + protocol + `"
+		skipssl = true
+	}
+
+	resource "ome_configuration_compliance" "baseline_remediation" {
+		baseline_name = "` + BaselineName + `"
+		target_devices = [
+			{
+				device_service_tag = "` + DeviceSvcTag1 + `"
+				compliance_status = "Compliant"
+			},
+		  ]
+		enforce = {
+			max_devices_per_apply = 1
+			maintenance_window = "* * 1-4 ? * SAT,SUN"
+		}
+	}
+`
+
 var testConfigureBaselineRemediationInvalidBaselineName = `
 	provider "ome" {
 		username = "` + omeUserName + `"
@@ -16,18 +16,22 @@ package ome
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"reflect"
 	"strconv"
 	"terraform-provider-ome/clients"
 	"terraform-provider-ome/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -38,6 +42,7 @@ var (
 	_ resource.Resource                = &resourceDeployment{}
 	_ resource.ResourceWithConfigure   = &resourceDeployment{}
 	_ resource.ResourceWithImportState = &resourceDeployment{}
+	_ resource.ResourceWithModifyPlan  = &resourceDeployment{}
 )
 
 // NewDeploymentResource is a new resource for deployment
@@ -113,6 +118,32 @@ func (r resourceDeployment) Schema(_ context.Context, _ resource.SchemaRequest,
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"slot_targets": schema.SetNestedAttribute{
+				MarkdownDescription: "List of the MX chassis slots to which a profile of the template is assigned." +
+					" A sled inserted into the slot picks up the profile." +
+					" The devices can be omitted when the template is only deployed to slots.",
+				Description: "List of the MX chassis slots to which a profile of the template is assigned." +
+					" A sled inserted into the slot picks up the profile." +
+					" The devices can be omitted when the template is only deployed to slots.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"chassis_servicetag": schema.StringAttribute{
+							MarkdownDescription: "Service tag of the chassis.",
+							Description:         "Service tag of the chassis.",
+							Required:            true,
+						},
+						"slot_number": schema.Int64Attribute{
+							MarkdownDescription: "Number of the compute slot in the chassis.",
+							Description:         "Number of the compute slot in the chassis.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
 			"boot_to_network_iso": schema.ObjectAttribute{
 				MarkdownDescription: "Boot To Network ISO deployment details.",
 				Description:         "Boot To Network ISO deployment details.",
@@ -222,10 +253,198 @@ func (r resourceDeployment) Schema(_ context.Context, _ resource.SchemaRequest,
 					Int64DefaultValue(types.Int64Value(60)),
 				},
 			},
+			"rollout": schema.SingleNestedAttribute{
+				MarkdownDescription: "Deploys the template on the target devices in batches, each batch is tracked till completion before the next one starts." +
+					" Without failure limits the rollout stops at the first failed device." +
+					" Devices which were not deployed are dropped from the state so that the next apply continues the rollout." +
+					" Conflicts with `run_later`.",
+				Description: "Deploys the template on the target devices in batches, each batch is tracked till completion before the next one starts." +
+					" Without failure limits the rollout stops at the first failed device." +
+					" Devices which were not deployed are dropped from the state so that the next apply continues the rollout." +
+					" Conflicts with 'run_later'.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"batch_size": schema.Int64Attribute{
+						MarkdownDescription: "Number of devices deployed in a batch." +
+							" Exactly one of `batch_size` and `batch_percentage` is required.",
+						Description: "Number of devices deployed in a batch." +
+							" Exactly one of 'batch_size' and 'batch_percentage' is required.",
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("batch_percentage")),
+						},
+					},
+					"batch_percentage": schema.Int64Attribute{
+						MarkdownDescription: "Percentage of the target devices deployed in a batch, rounded up to a whole device.",
+						Description:         "Percentage of the target devices deployed in a batch, rounded up to a whole device.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 100),
+						},
+					},
+					"pause_between_batches": schema.Int64Attribute{
+						MarkdownDescription: "Time to wait in seconds after a batch completes before the next batch starts.",
+						Description:         "Time to wait in seconds after a batch completes before the next batch starts.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"health_check": schema.BoolAttribute{
+						MarkdownDescription: "Counts a deployed device as failed when its health status is not normal after the batch completes.",
+						Description:         "Counts a deployed device as failed when its health status is not normal after the batch completes.",
+						Optional:            true,
+					},
+					"max_failures": schema.Int64Attribute{
+						MarkdownDescription: "Number of failed devices tolerated, the rollout stops once it is exceeded.",
+						Description:         "Number of failed devices tolerated, the rollout stops once it is exceeded.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"max_failure_percentage": schema.Int64Attribute{
+						MarkdownDescription: "Percentage of the target devices tolerated to fail, the rollout stops once it is exceeded.",
+						Description:         "Percentage of the target devices tolerated to fail, the rollout stops once it is exceeded.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+				},
+			},
+			"deployment_results": schema.ListNestedAttribute{
+				MarkdownDescription: "Result of the deployment on every target device.",
+				Description:         "Result of the deployment on every target device.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"device_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the device.",
+							Description:         "ID of the device.",
+							Computed:            true,
+						},
+						"service_tag": schema.StringAttribute{
+							MarkdownDescription: "Service tag of the device.",
+							Description:         "Service tag of the device.",
+							Computed:            true,
+						},
+						"batch": schema.Int64Attribute{
+							MarkdownDescription: "Batch in which the device was deployed.",
+							Description:         "Batch in which the device was deployed.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Result of the deployment, one of `success`, `failed`, `unhealthy`, `skipped` and `scheduled`.",
+							Description:         "Result of the deployment, one of 'success', 'failed', 'unhealthy', 'skipped' and 'scheduled'.",
+							Computed:            true,
+						},
+						"profile_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the server profile created for the device.",
+							Description:         "ID of the server profile created for the device.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Reason of the failure.",
+							Description:         "Reason of the failure.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"slot_status": schema.ListNestedAttribute{
+				MarkdownDescription: "Status of the profile assigned to every slot target.",
+				Description:         "Status of the profile assigned to every slot target.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"chassis_servicetag": schema.StringAttribute{
+							MarkdownDescription: "Service tag of the chassis.",
+							Description:         "Service tag of the chassis.",
+							Computed:            true,
+						},
+						"slot_number": schema.Int64Attribute{
+							MarkdownDescription: "Number of the slot in the chassis.",
+							Description:         "Number of the slot in the chassis.",
+							Computed:            true,
+						},
+						"slot_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the slot.",
+							Description:         "ID of the slot.",
+							Computed:            true,
+						},
+						"profile_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the profile assigned to the slot.",
+							Description:         "ID of the profile assigned to the slot.",
+							Computed:            true,
+						},
+						"profile_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the profile assigned to the slot.",
+							Description:         "Name of the profile assigned to the slot.",
+							Computed:            true,
+						},
+						"occupied": schema.BoolAttribute{
+							MarkdownDescription: "Whether a sled occupies the slot.",
+							Description:         "Whether a sled occupies the slot.",
+							Computed:            true,
+						},
+						"sled_servicetag": schema.StringAttribute{
+							MarkdownDescription: "Service tag of the sled in the slot.",
+							Description:         "Service tag of the sled in the slot.",
+							Computed:            true,
+						},
+						"profile_applied": schema.BoolAttribute{
+							MarkdownDescription: "Whether the profile is applied to the sled in the slot.",
+							Description:         "Whether the profile is applied to the sled in the slot.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// ModifyPlan validates that the chassis slots targeted by the deployment exist and are compute slots.
+// When the provider sets a firmware compliance guard, the devices and the sleds of the slots targeted by a created or updated deployment are checked against it.
+func (r resourceDeployment) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.p == nil || r.p.clientOpt == nil {
+		return
+	}
+	var plan models.TemplateDeployment
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var slotTargets []models.SlotTarget
+	if !plan.SlotTargets.IsNull() && !plan.SlotTargets.IsUnknown() {
+		resp.Diagnostics.Append(plan.SlotTargets.ElementsAs(ctx, &slotTargets, true)...)
+	}
+	checkGuard := r.p.firmwareGuard != nil && !req.Plan.Raw.Equal(req.State.Raw)
+	var deviceIDs []int64
+	var serviceTags []string
+	if checkGuard {
+		deviceIDs, serviceTags = firmwareGuardTargets(plan.DeviceIDs.Elements(), plan.DeviceServicetags.Elements())
+	}
+	if resp.Diagnostics.HasError() || len(slotTargets)+len(deviceIDs)+len(serviceTags) == 0 {
+		return
+	}
+
+	omeClient, d := r.p.createOMESession(ctx, "resource_deploy ModifyPlan")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	sledIDs, d := checkSlotTargets(omeClient, slotTargets)
+	resp.Diagnostics.Append(d...)
+	if checkGuard {
+		resp.Diagnostics.Append(r.p.checkFirmwareCompliance(ctx, omeClient, append(deviceIDs, sledIDs...), serviceTags)...)
+	}
+}
+
 // Create a new resource
 func (r resourceDeployment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "resource_deploy create : Started")
@@ -247,6 +466,9 @@ func (r resourceDeployment) Create(ctx context.Context, req resource.CreateReque
 	diags = plan.DeviceIDs.ElementsAs(ctx, &devIDs, true)
 	resp.Diagnostics.Append(diags...)
 
+	slotTargets, diags := getSlotTargets(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -277,58 +499,79 @@ func (r resourceDeployment) Create(ctx context.Context, req resource.CreateReque
 		"name": omeTemplate.Name,
 	})
 
-	usedDeviceInput, err := clients.DeviceMutuallyExclusive(serviceTags, devIDs)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			clients.ErrTemplateDeploymentCreate, err.Error(),
-		)
-		return
-	}
+	// the devices are optional when the template is only deployed to chassis slots
+	var usedDeviceInput string
+	var results []models.DeploymentResult
+	if len(serviceTags) > 0 || len(devIDs) > 0 || len(slotTargets) == 0 {
+		usedDeviceInput, err = clients.DeviceMutuallyExclusive(serviceTags, devIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				clients.ErrTemplateDeploymentCreate, err.Error(),
+			)
+			return
+		}
 
-	devices, err := omeClient.GetDevices(serviceTags, devIDs, []string{})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			clients.ErrTemplateDeploymentCreate, err.Error(),
-		)
-		return
-	}
+		devices, err := omeClient.GetDevices(serviceTags, devIDs, []string{})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				clients.ErrTemplateDeploymentCreate, err.Error(),
+			)
+			return
+		}
 
-	_, deviceIDs, _ := omeClient.GetUniqueDevicesIdsAndServiceTags(devices)
+		_, deviceIDs, _ := omeClient.GetUniqueDevicesIdsAndServiceTags(devices)
 
-	options := getOptions(plan)
+		options := getOptions(plan)
 
-	deploymentRequest := models.OMETemplateDeployRequest{
-		ID:        omeTemplate.ID,
-		TargetIDS: deviceIDs,
-		Options:   options,
-	}
+		deploymentRequest := models.OMETemplateDeployRequest{
+			ID:        omeTemplate.ID,
+			TargetIDS: deviceIDs,
+			Options:   options,
+		}
 
-	//Boot to Network ISO starts
-	if !plan.BootToNetworkISO.IsNull() {
-		bootToNetworkISOModel, diags, err := getBootToNetworkISO(ctx, plan)
-		if err != nil {
-			resp.Diagnostics.Append(diags...)
-			resp.Diagnostics.AddWarning(
-				clients.ErrUnableToParseData,
-				err.Error(),
-			)
+		//Boot to Network ISO starts
+		if !plan.BootToNetworkISO.IsNull() {
+			bootToNetworkISOModel, diags, err := getBootToNetworkISO(ctx, plan)
+			if err != nil {
+				resp.Diagnostics.Append(diags...)
+				resp.Diagnostics.AddWarning(
+					clients.ErrUnableToParseData,
+					err.Error(),
+				)
+			}
+			deploymentRequest.NetworkBootISOModel = bootToNetworkISOModel
 		}
-		deploymentRequest.NetworkBootISOModel = bootToNetworkISOModel
-	}
-	//Boot to Network ISO Ends
-	// Schedule Starts
-	if plan.RunLater.ValueBool() {
-		deploymentRequest.Schedule = getSchedule(plan)
-	}
-	//Schedule ends
-	// Device Attrs
-	if len(plan.DeviceAttributes.Elements()) > 0 {
-		deploymentRequest.Attributes = getDeviceAttributes(ctx, devices, plan)
-	}
+		//Boot to Network ISO Ends
+		// Schedule Starts
+		if plan.RunLater.ValueBool() {
+			deploymentRequest.Schedule = getSchedule(plan)
+		}
+		//Schedule ends
+		// Device Attrs
+		if len(plan.DeviceAttributes.Elements()) > 0 {
+			deploymentRequest.Attributes = getDeviceAttributes(ctx, devices, plan)
+		}
+
+		rollout, diags := newDeploymentRollout(ctx, plan, len(deviceIDs))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Trace(ctx, "resource_deploy create: started deployment")
 
-	tflog.Trace(ctx, "resource_deploy create: started creating deployment job")
+		results, diags = runDeployment(ctx, omeClient, deploymentRequest, omeTemplate.Name, devices, rollout, plan, clients.ErrTemplateDeploymentCreate)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-	deploymentJobID, err := omeClient.CreateDeployment(deploymentRequest)
+		tflog.Debug(ctx, "resource_deploy create: finished deployment", map[string]interface{}{
+			"results": len(results),
+		})
+	}
+
+	assignedProfileIDs, err := assignSlotProfiles(ctx, omeClient, omeTemplate.ID, omeTemplate.Name, slotTargets)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrTemplateDeploymentCreate, err.Error(),
@@ -336,29 +579,21 @@ func (r resourceDeployment) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	tflog.Debug(ctx, "resource_deploy create: finished creating deployment job", map[string]interface{}{
-		"deploymentJobID": deploymentJobID,
-	})
-
-	if !plan.RunLater.ValueBool() {
-		tflog.Trace(ctx, "resource_deploy create: started job tracking")
-		isSuccess, message := omeClient.TrackJob(deploymentJobID, plan.JobRetryCount.ValueInt64(), plan.SleepInterval.ValueInt64())
-		if !isSuccess {
-			resp.Diagnostics.AddWarning(
-				clients.ErrTemplateDeploymentCreate, message,
-			)
-		}
-	}
-
 	tflog.Trace(ctx, "resource_deploy create: updating state started")
 
-	stateUpdateErr := updateDeploymentState(&templateDeploymentState, &plan, omeTemplate.ID, omeTemplate.Name, omeClient, usedDeviceInput)
+	stateUpdateErr := updateDeploymentState(&templateDeploymentState, &plan, omeTemplate.ID, omeTemplate.Name, omeClient, usedDeviceInput, profileIDSet(assignedProfileIDs))
 	if stateUpdateErr != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrTemplateDeploymentCreate, stateUpdateErr.Error(),
 		)
 		return
 	}
+	resp.Diagnostics.Append(updateDeploymentResultsState(ctx, &templateDeploymentState, &plan, results)...)
+	templateDeploymentState.SlotTargets = plan.SlotTargets
+	resp.Diagnostics.Append(updateSlotState(ctx, omeClient, &templateDeploymentState, slotTargets, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Trace(ctx, "resource_deploy create: updating state finished, saving ...")
 	// Save into State
 	diags = resp.State.Set(ctx, &templateDeploymentState)
@@ -393,6 +628,12 @@ func (r resourceDeployment) Read(ctx context.Context, req resource.ReadRequest,
 	diags = stateTemplateDeployment.DeviceIDs.ElementsAs(ctx, &devIDs, true)
 	resp.Diagnostics.Append(diags...)
 
+	slotTargets, diags := getSlotTargets(ctx, stateTemplateDeployment)
+	resp.Diagnostics.Append(diags...)
+
+	slotStatus, diags := getSlotStatus(ctx, stateTemplateDeployment)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -412,14 +653,31 @@ func (r resourceDeployment) Read(ctx context.Context, req resource.ReadRequest,
 	}
 	defer omeClient.RemoveSession()
 
+	// the deployment no longer exists once its template is deleted
+	if _, httpResponse, err := omeClient.GetTemplateByID(templateID); err != nil {
+		if clients.IsNotFound(err) || (httpResponse != nil && httpResponse.StatusCode == http.StatusBadRequest) {
+			tflog.Info(ctx, fmt.Sprintf("Unable to find template (%v), clearing state", templateID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			clients.ErrTemplateDeploymentRead, err.Error(),
+		)
+		return
+	}
+
 	tflog.Trace(ctx, "resource_deploy read: client created started updating state")
-	stateUpdateErr := updateDeploymentState(&stateTemplateDeployment, &stateTemplateDeployment, templateID, templateName, omeClient, usedDeviceInput)
+	stateUpdateErr := updateDeploymentState(&stateTemplateDeployment, &stateTemplateDeployment, templateID, templateName, omeClient, usedDeviceInput, profileIDSet(slotProfileIDs(slotStatus)))
 	if stateUpdateErr != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrTemplateDeploymentRead, stateUpdateErr.Error(),
 		)
 		return
 	}
+	resp.Diagnostics.Append(updateSlotState(ctx, omeClient, &stateTemplateDeployment, slotTargets, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Trace(ctx, "resource_deploy read: finished reading state")
 	//Save into State
 	diags = resp.State.Set(ctx, &stateTemplateDeployment)
@@ -487,35 +745,49 @@ func (r resourceDeployment) Update(ctx context.Context, req resource.UpdateReque
 	diags = state.DeviceIDs.ElementsAs(ctx, &stateDevIDs, true)
 	resp.Diagnostics.Append(diags...)
 
+	slotTargets, diags := getSlotTargets(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	stateSlotStatus, diags := getSlotStatus(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	usedDeviceInput, err := clients.DeviceMutuallyExclusive(serviceTags, devIDs)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			clients.ErrTemplateDeploymentUpdate, err.Error(),
-		)
-		return
-	}
+	// the devices are optional when the template is only deployed to chassis slots
+	var usedDeviceInput string
+	var planDevices []models.Device
+	var err error
+	if len(serviceTags) > 0 || len(devIDs) > 0 || len(slotTargets) == 0 {
+		usedDeviceInput, err = clients.DeviceMutuallyExclusive(serviceTags, devIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				clients.ErrTemplateDeploymentUpdate, err.Error(),
+			)
+			return
+		}
 
-	planDevices, err := omeClient.GetDevices(serviceTags, devIDs, []string{})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			clients.ErrTemplateDeploymentUpdate, err.Error(),
-		)
-		return
+		planDevices, err = omeClient.GetDevices(serviceTags, devIDs, []string{})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				clients.ErrTemplateDeploymentUpdate, err.Error(),
+			)
+			return
+		}
 	}
 
 	_, planDeviceIDs, _ := omeClient.GetUniqueDevicesIdsAndServiceTags(planDevices)
 
-	serverProfiles, err := omeClient.GetServerProfileInfoByTemplateName(state.TemplateName.ValueString())
+	allServerProfiles, err := omeClient.GetServerProfileInfoByTemplateName(state.TemplateName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrTemplateDeploymentUpdate, err.Error(),
 		)
 		return
 	}
+	// the profiles assigned to chassis slots are handled separately
+	serverProfiles := withoutSlotProfiles(allServerProfiles, profileIDSet(slotProfileIDs(stateSlotStatus)))
 
 	var stateDeviceIDs []int64
 
@@ -564,24 +836,18 @@ func (r resourceDeployment) Update(ctx context.Context, req resource.UpdateReque
 		deploymentRequest.Attributes = getDeviceAttributes(ctx, planDevices, plan)
 	}
 
+	var results []models.DeploymentResult
 	if len(newDeployDevIDs) > 0 {
-		tflog.Trace(ctx, "resource_deploy update: started deployment")
-		deploymentJobID, err := omeClient.CreateDeployment(deploymentRequest)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				clients.ErrTemplateDeploymentUpdate, err.Error(),
-			)
+		rollout, diags := newDeploymentRollout(ctx, plan, len(newDeployDevIDs))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-
-		if !plan.RunLater.ValueBool() {
-			tflog.Trace(ctx, "resource_deploy update: started job tracking")
-			isSuccess, message := omeClient.TrackJob(deploymentJobID, plan.JobRetryCount.ValueInt64(), plan.SleepInterval.ValueInt64())
-			if !isSuccess {
-				resp.Diagnostics.AddWarning(
-					"unable to complete the deployment for the template: ", message,
-				)
-			}
+		tflog.Trace(ctx, "resource_deploy update: started deployment")
+		results, diags = runDeployment(ctx, omeClient, deploymentRequest, state.TemplateName.ValueString(), planDevices, rollout, plan, clients.ErrTemplateDeploymentUpdate)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
@@ -607,15 +873,54 @@ func (r resourceDeployment) Update(ctx context.Context, req resource.UpdateReque
 		}
 	}
 
+	newSlotTargets, removeSlotProfileIDs := diffSlotTargets(slotTargets, stateSlotStatus)
+	newSlotProfileIDs, err := assignSlotProfiles(ctx, omeClient, state.TemplateID.ValueInt64(), state.TemplateName.ValueString(), newSlotTargets)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			clients.ErrTemplateDeploymentUpdate, err.Error(),
+		)
+		return
+	}
+
+	if len(removeSlotProfileIDs) > 0 {
+		tflog.Debug(ctx, "resource_deploy update: deleting slot profiles", map[string]interface{}{
+			"profileIds": removeSlotProfileIDs,
+		})
+		err = deleteProfiles(ctx, omeClient, removeSlotProfileIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				clients.ErrTemplateDeploymentUpdate,
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	tflog.Trace(ctx, "resource_deploy update: started state update")
 
-	stateUpdateErr := updateDeploymentState(&state, &plan, state.TemplateID.ValueInt64(), state.TemplateName.ValueString(), omeClient, usedDeviceInput)
+	var previousResults []models.DeploymentResult
+	if !state.DeploymentResults.IsNull() && !state.DeploymentResults.IsUnknown() {
+		resp.Diagnostics.Append(state.DeploymentResults.ElementsAs(ctx, &previousResults, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	results = mergeDeploymentResults(previousResults, results, planDeviceIDs)
+
+	profileIDs := append(slotProfileIDs(stateSlotStatus), newSlotProfileIDs...)
+	stateUpdateErr := updateDeploymentState(&state, &plan, state.TemplateID.ValueInt64(), state.TemplateName.ValueString(), omeClient, usedDeviceInput, profileIDSet(profileIDs))
 	if stateUpdateErr != nil {
 		resp.Diagnostics.AddError(
 			clients.ErrTemplateDeploymentUpdate, stateUpdateErr.Error(),
 		)
 		return
 	}
+	resp.Diagnostics.Append(updateDeploymentResultsState(ctx, &state, &plan, results)...)
+	state.SlotTargets = plan.SlotTargets
+	resp.Diagnostics.Append(updateSlotState(ctx, omeClient, &state, slotTargets, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Trace(ctx, "resource_deploy update: finished state update")
 	//Save into State
 	diags = resp.State.Set(ctx, &state)
@@ -865,6 +1170,10 @@ func (r resourceDeployment) ImportState(ctx context.Context, req resource.Import
 	if !bootToNetworkISOTfsdk.IsUnknown() {
 		stateTemplateDeployment.BootToNetworkISO = bootToNetworkISOTfsdk
 	}
+	stateTemplateDeployment.Rollout = types.ObjectNull(deploymentRolloutAttrTypes)
+	stateTemplateDeployment.DeploymentResults = types.ListNull(deploymentResultType)
+	stateTemplateDeployment.SlotTargets = types.SetNull(slotTargetType)
+	stateTemplateDeployment.SlotStatus = types.ListNull(slotStatusType)
 	//Save into State
 	diags := resp.State.Set(ctx, &stateTemplateDeployment)
 	resp.Diagnostics.Append(diags...)
@@ -874,7 +1183,7 @@ func (r resourceDeployment) ImportState(ctx context.Context, req resource.Import
 	tflog.Trace(ctx, "resource_deploy import: finished")
 }
 
-func updateDeploymentState(stateTemplateDeployment, planTemplateDeployment *models.TemplateDeployment, templateID int64, templateName string, omeClient *clients.Client, usedDeviceInput string) error {
+func updateDeploymentState(stateTemplateDeployment, planTemplateDeployment *models.TemplateDeployment, templateID int64, templateName string, omeClient *clients.Client, usedDeviceInput string, slotProfiles map[int64]bool) error {
 	stateTemplateDeployment.ID = types.StringValue(strconv.FormatInt(templateID, 10))
 	stateTemplateDeployment.TemplateID = types.Int64Value(templateID)
 	stateTemplateDeployment.TemplateName = types.StringValue(templateName)
@@ -892,7 +1201,7 @@ func updateDeploymentState(stateTemplateDeployment, planTemplateDeployment *mode
 	if err != nil {
 		return err
 	}
-	for _, serverProfile := range serverProfiles.Value {
+	for _, serverProfile := range withoutSlotProfiles(serverProfiles, slotProfiles).Value {
 		device, _ := omeClient.GetDevice("", serverProfile.TargetID)
 		deviceSTVal := types.StringValue(device.DeviceServiceTag)
 		profileDevSTVals = append(profileDevSTVals, deviceSTVal)
@@ -942,6 +1251,9 @@ func updateDeploymentState(stateTemplateDeployment, planTemplateDeployment *mode
 		)
 		stateTemplateDeployment.DeviceIDs = devIDsTfsdk
 		stateTemplateDeployment.DeviceServicetags = types.SetNull(types.StringType)
+	default:
+		stateTemplateDeployment.DeviceIDs = types.SetNull(types.Int64Type)
+		stateTemplateDeployment.DeviceServicetags = types.SetNull(types.StringType)
 	}
 	if !planTemplateDeployment.BootToNetworkISO.IsUnknown() {
 		stateTemplateDeployment.BootToNetworkISO = planTemplateDeployment.BootToNetworkISO
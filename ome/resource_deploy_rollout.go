@@ -0,0 +1,344 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DeploymentStatusSuccess is the result of a device that has a server profile of the template
+	DeploymentStatusSuccess = "success"
+	// DeploymentStatusFailed is the result of a device for which no server profile was created
+	DeploymentStatusFailed = "failed"
+	// DeploymentStatusUnhealthy is the result of a deployed device that failed the health check
+	DeploymentStatusUnhealthy = "unhealthy"
+	// DeploymentStatusSkipped is the result of a device that was not deployed because the rollout stopped
+	DeploymentStatusSkipped = "skipped"
+	// DeploymentStatusScheduled is the result of a device whose deployment runs later
+	DeploymentStatusScheduled = "scheduled"
+	// DeviceHealthNormal is the OME health status of a device without warnings or critical alerts
+	DeviceHealthNormal = 1000
+)
+
+var deploymentRolloutAttrTypes = map[string]attr.Type{
+	"batch_size":             types.Int64Type,
+	"batch_percentage":       types.Int64Type,
+	"pause_between_batches":  types.Int64Type,
+	"health_check":           types.BoolType,
+	"max_failures":           types.Int64Type,
+	"max_failure_percentage": types.Int64Type,
+}
+
+var deploymentResultType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"device_id":   types.Int64Type,
+		"service_tag": types.StringType,
+		"batch":       types.Int64Type,
+		"status":      types.StringType,
+		"profile_id":  types.Int64Type,
+		"message":     types.StringType,
+	},
+}
+
+// deploymentRollout describes how the target devices of a deployment are split into batches.
+// A negative failure limit means the limit is not set.
+type deploymentRollout struct {
+	batchSize            int
+	pauseBetweenBatches  int64
+	healthCheck          bool
+	maxFailures          int64
+	maxFailurePercentage int64
+}
+
+// newDeploymentRollout returns the rollout of the plan for the given number of target devices.
+// Without a rollout block all the devices are deployed in a single batch.
+func newDeploymentRollout(ctx context.Context, plan models.TemplateDeployment, targets int) (deploymentRollout, diag.Diagnostics) {
+	rollout := deploymentRollout{
+		batchSize:            targets,
+		maxFailures:          -1,
+		maxFailurePercentage: -1,
+	}
+	if plan.Rollout.IsNull() || plan.Rollout.IsUnknown() {
+		return rollout, nil
+	}
+	var diags diag.Diagnostics
+	if plan.RunLater.ValueBool() {
+		diags.AddError(clients.ErrTemplateDeploymentGeneral, "rollout cannot be used with run_later, a scheduled deployment cannot be split into batches")
+		return rollout, diags
+	}
+	var planRollout models.DeploymentRollout
+	diags.Append(plan.Rollout.As(ctx, &planRollout, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return rollout, diags
+	}
+	if !planRollout.BatchSize.IsNull() {
+		rollout.batchSize = int(planRollout.BatchSize.ValueInt64())
+	} else if !planRollout.BatchPercentage.IsNull() {
+		rollout.batchSize = (targets*int(planRollout.BatchPercentage.ValueInt64()) + 99) / 100
+	}
+	if rollout.batchSize < 1 {
+		rollout.batchSize = 1
+	}
+	rollout.pauseBetweenBatches = planRollout.PauseBetweenBatches.ValueInt64()
+	rollout.healthCheck = planRollout.HealthCheck.ValueBool()
+	// a rollout without failure limits stops at the first failed device
+	rollout.maxFailures = 0
+	if !planRollout.MaxFailurePercentage.IsNull() {
+		rollout.maxFailures = -1
+		rollout.maxFailurePercentage = planRollout.MaxFailurePercentage.ValueInt64()
+	}
+	if !planRollout.MaxFailures.IsNull() {
+		rollout.maxFailures = planRollout.MaxFailures.ValueInt64()
+	}
+	return rollout, diags
+}
+
+// batches splits the device ids into batches of the rollout batch size, keeping their order.
+func (r deploymentRollout) batches(deviceIDs []int64) [][]int64 {
+	batches := [][]int64{}
+	size := r.batchSize
+	if size < 1 {
+		size = len(deviceIDs)
+	}
+	for start := 0; start < len(deviceIDs); start += size {
+		end := start + size
+		if end > len(deviceIDs) {
+			end = len(deviceIDs)
+		}
+		batches = append(batches, deviceIDs[start:end])
+	}
+	return batches
+}
+
+// thresholdReached returns true when the failed devices exceed the failure limits of the rollout.
+func (r deploymentRollout) thresholdReached(failures, targets int) bool {
+	if r.maxFailures >= 0 && int64(failures) > r.maxFailures {
+		return true
+	}
+	return r.maxFailurePercentage >= 0 && targets > 0 && int64(failures)*100 > r.maxFailurePercentage*int64(targets)
+}
+
+// filterDeviceAttributes returns the device attributes of the given devices.
+func filterDeviceAttributes(deviceAttributes []models.OMEDeviceAttributes, deviceIDs []int64) []models.OMEDeviceAttributes {
+	if len(deviceAttributes) == 0 {
+		return deviceAttributes
+	}
+	filtered := []models.OMEDeviceAttributes{}
+	for _, deviceAttribute := range deviceAttributes {
+		for _, deviceID := range deviceIDs {
+			if deviceAttribute.DeviceID == deviceID {
+				filtered = append(filtered, deviceAttribute)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+func newDeploymentResult(deviceID int64, serviceTag string, batch int, status, message string) models.DeploymentResult {
+	return models.DeploymentResult{
+		DeviceID:   types.Int64Value(deviceID),
+		ServiceTag: types.StringValue(serviceTag),
+		Batch:      types.Int64Value(int64(batch)),
+		Status:     types.StringValue(status),
+		ProfileID:  types.Int64Null(),
+		Message:    types.StringValue(message),
+	}
+}
+
+// runDeployment deploys the template on the target devices of the request batch by batch.
+// Every batch is tracked till completion, the devices are checked for a server profile and optionally for their health,
+// and the rollout stops once the failures exceed the limits of the rollout. The remaining devices are reported as skipped.
+func runDeployment(ctx context.Context, omeClient *clients.Client, request models.OMETemplateDeployRequest, templateName string, devices []models.Device,
+	rollout deploymentRollout, plan models.TemplateDeployment, errSummary string) ([]models.DeploymentResult, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	results := []models.DeploymentResult{}
+	serviceTags := map[int64]string{}
+	for _, device := range devices {
+		serviceTags[device.ID] = device.DeviceServiceTag
+	}
+
+	batches := rollout.batches(request.TargetIDS)
+	failures := 0
+	stopped := false
+	for i, batch := range batches {
+		batchNumber := i + 1
+		if stopped {
+			for _, deviceID := range batch {
+				results = append(results, newDeploymentResult(deviceID, serviceTags[deviceID], batchNumber, DeploymentStatusSkipped, "rollout stopped before this batch"))
+			}
+			continue
+		}
+
+		tflog.Debug(ctx, "deployment: started batch", map[string]interface{}{
+			"batch":     batchNumber,
+			"targetIds": batch,
+		})
+		batchRequest := request
+		batchRequest.TargetIDS = batch
+		batchRequest.Attributes = filterDeviceAttributes(request.Attributes, batch)
+		deploymentJobID, err := omeClient.CreateDeployment(batchRequest)
+		if err != nil {
+			if batchNumber == 1 {
+				diags.AddError(errSummary, err.Error())
+				return results, diags
+			}
+			for _, deviceID := range batch {
+				results = append(results, newDeploymentResult(deviceID, serviceTags[deviceID], batchNumber, DeploymentStatusFailed, err.Error()))
+			}
+			failures += len(batch)
+		} else if plan.RunLater.ValueBool() {
+			for _, deviceID := range batch {
+				results = append(results, newDeploymentResult(deviceID, serviceTags[deviceID], batchNumber, DeploymentStatusScheduled, fmt.Sprintf("deployment job %d is scheduled", deploymentJobID)))
+			}
+			continue
+		} else {
+			batchFailures, batchDiags := trackDeploymentBatch(ctx, omeClient, deploymentJobID, batch, batchNumber, templateName, serviceTags, rollout, plan, errSummary, &results)
+			diags.Append(batchDiags...)
+			if diags.HasError() {
+				return results, diags
+			}
+			failures += batchFailures
+		}
+
+		if batchNumber == len(batches) {
+			continue
+		}
+		if rollout.thresholdReached(failures, len(request.TargetIDS)) {
+			stopped = true
+			diags.AddWarning(clients.ErrTemplateDeploymentRolloutStopped,
+				fmt.Sprintf("%d of %d devices failed by batch %d of %d, the remaining devices were not deployed."+
+					" Resolve the failures and apply again to continue the rollout.", failures, len(request.TargetIDS), batchNumber, len(batches)))
+		} else if rollout.pauseBetweenBatches > 0 {
+			tflog.Trace(ctx, fmt.Sprintf("deployment: pausing %d seconds before the next batch", rollout.pauseBetweenBatches))
+			time.Sleep(time.Duration(rollout.pauseBetweenBatches) * time.Second)
+		}
+	}
+	return results, diags
+}
+
+// trackDeploymentBatch tracks the deployment job of a batch and appends the result of every device of the batch.
+// It returns the number of devices of the batch that failed.
+func trackDeploymentBatch(ctx context.Context, omeClient *clients.Client, deploymentJobID int64, batch []int64, batchNumber int, templateName string,
+	serviceTags map[int64]string, rollout deploymentRollout, plan models.TemplateDeployment, errSummary string, results *[]models.DeploymentResult) (int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	tflog.Trace(ctx, "deployment: started job tracking")
+	isSuccess, message := omeClient.TrackJob(deploymentJobID, plan.JobRetryCount.ValueInt64(), plan.SleepInterval.ValueInt64())
+	if !isSuccess {
+		diags.AddWarning(errSummary, message)
+	}
+	if message == "" {
+		message = "no server profile was created for the device"
+	}
+
+	serverProfiles, err := omeClient.GetServerProfileInfoByTemplateName(templateName)
+	if err != nil {
+		diags.AddError(errSummary, err.Error())
+		return 0, diags
+	}
+	profileIDs := map[int64]int64{}
+	for _, serverProfile := range serverProfiles.Value {
+		profileIDs[serverProfile.TargetID] = serverProfile.ID
+	}
+
+	failures := 0
+	for _, deviceID := range batch {
+		profileID, ok := profileIDs[deviceID]
+		if !ok {
+			*results = append(*results, newDeploymentResult(deviceID, serviceTags[deviceID], batchNumber, DeploymentStatusFailed, message))
+			failures++
+			continue
+		}
+		result := newDeploymentResult(deviceID, serviceTags[deviceID], batchNumber, DeploymentStatusSuccess, "")
+		result.ProfileID = types.Int64Value(profileID)
+		if rollout.healthCheck {
+			device, err := omeClient.GetDevice("", deviceID)
+			if err != nil {
+				result.Status = types.StringValue(DeploymentStatusUnhealthy)
+				result.Message = types.StringValue(err.Error())
+			} else if device.Status != DeviceHealthNormal {
+				result.Status = types.StringValue(DeploymentStatusUnhealthy)
+				result.Message = types.StringValue(fmt.Sprintf("device health status is %d", device.Status))
+			}
+		}
+		if result.Status.ValueString() != DeploymentStatusSuccess {
+			failures++
+		}
+		*results = append(*results, result)
+	}
+	return failures, diags
+}
+
+// mergeDeploymentResults keeps the previous results of the devices that are still targeted and not part of the current results.
+func mergeDeploymentResults(previous, current []models.DeploymentResult, targetIDs []int64) []models.DeploymentResult {
+	merged := []models.DeploymentResult{}
+	for _, result := range previous {
+		deviceID := result.DeviceID.ValueInt64()
+		if !containsDeviceID(targetIDs, deviceID) || containsDeploymentResult(current, deviceID) {
+			continue
+		}
+		merged = append(merged, result)
+	}
+	return append(merged, current...)
+}
+
+func containsDeviceID(deviceIDs []int64, deviceID int64) bool {
+	for _, id := range deviceIDs {
+		if id == deviceID {
+			return true
+		}
+	}
+	return false
+}
+
+func containsDeploymentResult(results []models.DeploymentResult, deviceID int64) bool {
+	for _, result := range results {
+		if result.DeviceID.ValueInt64() == deviceID {
+			return true
+		}
+	}
+	return false
+}
+
+func newDeploymentResultsList(ctx context.Context, results []models.DeploymentResult) (types.List, diag.Diagnostics) {
+	return types.ListValueFrom(ctx, deploymentResultType, results)
+}
+
+// updateDeploymentResultsState saves the rollout and the deployment results into the state.
+// With a rollout the configured devices are kept in the state, the next refresh drops the devices without a server profile
+// so that a re-apply continues the rollout with them.
+func updateDeploymentResultsState(ctx context.Context, stateTemplateDeployment, planTemplateDeployment *models.TemplateDeployment, results []models.DeploymentResult) diag.Diagnostics {
+	resultsTfsdk, diags := newDeploymentResultsList(ctx, results)
+	if diags.HasError() {
+		return diags
+	}
+	stateTemplateDeployment.DeploymentResults = resultsTfsdk
+	stateTemplateDeployment.Rollout = planTemplateDeployment.Rollout
+	if !planTemplateDeployment.Rollout.IsNull() {
+		stateTemplateDeployment.DeviceIDs = planTemplateDeployment.DeviceIDs
+		stateTemplateDeployment.DeviceServicetags = planTemplateDeployment.DeviceServicetags
+	}
+	return diags
+}
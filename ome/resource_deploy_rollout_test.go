@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"terraform-provider-ome/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeploymentRollout(t *testing.T) {
+	ctx := context.Background()
+	newPlan := func(rollout map[string]attr.Value) models.TemplateDeployment {
+		plan := models.TemplateDeployment{Rollout: types.ObjectNull(deploymentRolloutAttrTypes)}
+		if rollout != nil {
+			values := map[string]attr.Value{}
+			for name, attrType := range deploymentRolloutAttrTypes {
+				if attrType == types.BoolType {
+					values[name] = types.BoolNull()
+				} else {
+					values[name] = types.Int64Null()
+				}
+			}
+			for name, value := range rollout {
+				values[name] = value
+			}
+			plan.Rollout = types.ObjectValueMust(deploymentRolloutAttrTypes, values)
+		}
+		return plan
+	}
+
+	rollout, diags := newDeploymentRollout(ctx, newPlan(nil), 5)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, [][]int64{{1, 2, 3, 4, 5}}, rollout.batches([]int64{1, 2, 3, 4, 5}))
+	assert.False(t, rollout.thresholdReached(5, 5))
+
+	rollout, diags = newDeploymentRollout(ctx, newPlan(map[string]attr.Value{"batch_size": types.Int64Value(2)}), 5)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, [][]int64{{1, 2}, {3, 4}, {5}}, rollout.batches([]int64{1, 2, 3, 4, 5}))
+	assert.False(t, rollout.thresholdReached(0, 5))
+	assert.True(t, rollout.thresholdReached(1, 5))
+
+	rollout, diags = newDeploymentRollout(ctx, newPlan(map[string]attr.Value{
+		"batch_percentage":       types.Int64Value(30),
+		"max_failure_percentage": types.Int64Value(20),
+	}), 10)
+	assert.False(t, diags.HasError())
+	assert.Len(t, rollout.batches([]int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}), 4)
+	assert.False(t, rollout.thresholdReached(2, 10))
+	assert.True(t, rollout.thresholdReached(3, 10))
+
+	rollout, diags = newDeploymentRollout(ctx, newPlan(map[string]attr.Value{
+		"batch_percentage": types.Int64Value(1),
+		"max_failures":     types.Int64Value(3),
+	}), 10)
+	assert.False(t, diags.HasError())
+	assert.Len(t, rollout.batches([]int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}), 10)
+	assert.False(t, rollout.thresholdReached(3, 10))
+	assert.True(t, rollout.thresholdReached(4, 10))
+
+	plan := newPlan(map[string]attr.Value{"batch_size": types.Int64Value(2)})
+	plan.RunLater = types.BoolValue(true)
+	_, diags = newDeploymentRollout(ctx, plan, 5)
+	assert.True(t, diags.HasError())
+}
+
+func TestMergeDeploymentResults(t *testing.T) {
+	previous := []models.DeploymentResult{
+		newDeploymentResult(1, "ST1", 1, DeploymentStatusSuccess, ""),
+		newDeploymentResult(2, "ST2", 1, DeploymentStatusFailed, "failed"),
+		newDeploymentResult(3, "ST3", 2, DeploymentStatusSuccess, ""),
+	}
+	current := []models.DeploymentResult{
+		newDeploymentResult(2, "ST2", 1, DeploymentStatusSuccess, ""),
+	}
+	merged := mergeDeploymentResults(previous, current, []int64{1, 2})
+	assert.Len(t, merged, 2)
+	assert.Equal(t, int64(1), merged[0].DeviceID.ValueInt64())
+	assert.Equal(t, DeploymentStatusSuccess, merged[1].Status.ValueString())
+
+	list, diags := newDeploymentResultsList(context.Background(), merged)
+	assert.False(t, diags.HasError())
+	assert.Len(t, list.Elements(), 2)
+}
+
+func TestFilterDeviceAttributes(t *testing.T) {
+	deviceAttributes := []models.OMEDeviceAttributes{{DeviceID: 1}, {DeviceID: 2}, {DeviceID: 3}}
+	assert.Equal(t, []models.OMEDeviceAttributes{{DeviceID: 1}, {DeviceID: 3}}, filterDeviceAttributes(deviceAttributes, []int64{3, 1}))
+	assert.Empty(t, filterDeviceAttributes(nil, []int64{1}))
+}
@@ -0,0 +1,260 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var slotTargetType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"chassis_servicetag": types.StringType,
+		"slot_number":        types.Int64Type,
+	},
+}
+
+var slotStatusType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"chassis_servicetag": types.StringType,
+		"slot_number":        types.Int64Type,
+		"slot_id":            types.Int64Type,
+		"profile_id":         types.Int64Type,
+		"profile_name":       types.StringType,
+		"occupied":           types.BoolType,
+		"sled_servicetag":    types.StringType,
+		"profile_applied":    types.BoolType,
+	},
+}
+
+// checkSlotTargets validates that the chassis slots targeted by the deployment exist and are compute slots.
+// It returns the ids of the sleds held by the slots.
+func checkSlotTargets(omeClient *clients.Client, slotTargets []models.SlotTarget) ([]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	sledIDs := []int64{}
+	for _, slotTarget := range slotTargets {
+		if slotTarget.ChassisServicetag.IsUnknown() || slotTarget.SlotNumber.IsUnknown() {
+			continue
+		}
+		slot, err := omeClient.GetChassisComputeSlot(slotTarget.ChassisServicetag.ValueString(), slotTarget.SlotNumber.ValueInt64())
+		if err != nil {
+			diags.AddAttributeError(path.Root("slot_targets"), clients.ErrInvalidSlotTarget, err.Error())
+			continue
+		}
+		if slot.DeviceID != 0 {
+			sledIDs = append(sledIDs, slot.DeviceID)
+		}
+	}
+	return sledIDs, diags
+}
+
+func slotTargetKey(chassisServiceTag string, slotNumber int64) string {
+	return fmt.Sprintf("%s/%d", chassisServiceTag, slotNumber)
+}
+
+// getSlotTargets returns the slot targets of the plan or state
+func getSlotTargets(ctx context.Context, deployment models.TemplateDeployment) ([]models.SlotTarget, diag.Diagnostics) {
+	slotTargets := []models.SlotTarget{}
+	if deployment.SlotTargets.IsNull() || deployment.SlotTargets.IsUnknown() {
+		return slotTargets, nil
+	}
+	diags := deployment.SlotTargets.ElementsAs(ctx, &slotTargets, false)
+	return slotTargets, diags
+}
+
+// getSlotStatus returns the status of the slots saved in the state
+func getSlotStatus(ctx context.Context, deployment models.TemplateDeployment) ([]models.SlotStatus, diag.Diagnostics) {
+	slotStatus := []models.SlotStatus{}
+	if deployment.SlotStatus.IsNull() || deployment.SlotStatus.IsUnknown() {
+		return slotStatus, nil
+	}
+	diags := deployment.SlotStatus.ElementsAs(ctx, &slotStatus, false)
+	return slotStatus, diags
+}
+
+// slotProfileIDs returns the ids of the slot profiles saved in the state
+func slotProfileIDs(slotStatus []models.SlotStatus) []int64 {
+	profileIDs := []int64{}
+	for _, status := range slotStatus {
+		profileIDs = append(profileIDs, status.ProfileID.ValueInt64())
+	}
+	return profileIDs
+}
+
+// profileIDSet returns the profile ids as a set
+func profileIDSet(profileIDs []int64) map[int64]bool {
+	profileIDMap := map[int64]bool{}
+	for _, profileID := range profileIDs {
+		profileIDMap[profileID] = true
+	}
+	return profileIDMap
+}
+
+// withoutSlotProfiles returns the profiles of the template assigned to devices.
+// The slot profiles are left out by id, OME reports them against the slot or against the sled held by the slot.
+func withoutSlotProfiles(serverProfiles models.OMEServerProfiles, slotProfileIDs map[int64]bool) models.OMEServerProfiles {
+	deviceProfiles := models.OMEServerProfiles{Value: []models.OMEServerProfile{}}
+	for _, serverProfile := range serverProfiles.Value {
+		if !slotProfileIDs[serverProfile.ID] {
+			deviceProfiles.Value = append(deviceProfiles.Value, serverProfile)
+		}
+	}
+	return deviceProfiles
+}
+
+// diffSlotTargets returns the slot targets without a profile and the profiles of the slots no longer targeted
+func diffSlotTargets(slotTargets []models.SlotTarget, slotStatus []models.SlotStatus) ([]models.SlotTarget, []int64) {
+	assigned := map[string]bool{}
+	for _, status := range slotStatus {
+		assigned[slotTargetKey(status.ChassisServicetag.ValueString(), status.SlotNumber.ValueInt64())] = true
+	}
+	targeted := map[string]bool{}
+	newSlotTargets := []models.SlotTarget{}
+	for _, slotTarget := range slotTargets {
+		key := slotTargetKey(slotTarget.ChassisServicetag.ValueString(), slotTarget.SlotNumber.ValueInt64())
+		targeted[key] = true
+		if !assigned[key] {
+			newSlotTargets = append(newSlotTargets, slotTarget)
+		}
+	}
+	removeProfileIDs := []int64{}
+	for _, status := range slotStatus {
+		if !targeted[slotTargetKey(status.ChassisServicetag.ValueString(), status.SlotNumber.ValueInt64())] {
+			removeProfileIDs = append(removeProfileIDs, status.ProfileID.ValueInt64())
+		}
+	}
+	return newSlotTargets, removeProfileIDs
+}
+
+// assignSlotProfiles creates a profile from the template for every slot target and assigns it to the slot.
+// It returns the ids of the profiles assigned to the slots.
+func assignSlotProfiles(ctx context.Context, omeClient *clients.Client, templateID int64, templateName string, slotTargets []models.SlotTarget) ([]int64, error) {
+	profileIDs := []int64{}
+	for _, slotTarget := range slotTargets {
+		chassisServiceTag, slotNumber := slotTarget.ChassisServicetag.ValueString(), slotTarget.SlotNumber.ValueInt64()
+		slot, err := omeClient.GetChassisComputeSlot(chassisServiceTag, slotNumber)
+		if err != nil {
+			return profileIDs, err
+		}
+		profileID, err := omeClient.CreateProfile(templateID, templateName)
+		if err != nil {
+			return profileIDs, err
+		}
+		tflog.Debug(ctx, "resource_deploy: assigning profile to slot", map[string]interface{}{
+			"profileId": profileID,
+			"slot":      slotTargetKey(chassisServiceTag, slotNumber),
+		})
+		err = omeClient.AssignProfile(profileID, slot.ID)
+		if err != nil {
+			_ = deleteProfiles(ctx, omeClient, []int64{profileID})
+			return profileIDs, fmt.Errorf("unable to assign the profile to slot %d of chassis %s: %w", slotNumber, chassisServiceTag, err)
+		}
+		profileIDs = append(profileIDs, profileID)
+	}
+	return profileIDs, nil
+}
+
+// refreshSlotStatus reads the profile and the sled of every slot target.
+// Slot targets whose profile no longer exists are left out.
+func refreshSlotStatus(omeClient *clients.Client, slotTargets []models.SlotTarget, serverProfiles models.OMEServerProfiles) ([]models.SlotTarget, []models.SlotStatus, error) {
+	existingTargets := []models.SlotTarget{}
+	slotStatus := []models.SlotStatus{}
+	for _, slotTarget := range slotTargets {
+		chassisServiceTag, slotNumber := slotTarget.ChassisServicetag.ValueString(), slotTarget.SlotNumber.ValueInt64()
+		slot, err := omeClient.GetChassisComputeSlot(chassisServiceTag, slotNumber)
+		if err != nil {
+			return nil, nil, err
+		}
+		profile := findSlotProfile(serverProfiles, slot)
+		if profile == nil {
+			continue
+		}
+		status := models.SlotStatus{
+			ChassisServicetag: types.StringValue(chassisServiceTag),
+			SlotNumber:        types.Int64Value(slotNumber),
+			SlotID:            types.Int64Value(slot.ID),
+			ProfileID:         types.Int64Value(profile.ID),
+			ProfileName:       types.StringValue(profile.ProfileName),
+			Occupied:          types.BoolValue(slot.DeviceID != 0),
+			SledServicetag:    types.StringNull(),
+			ProfileApplied:    types.BoolValue(false),
+		}
+		if slot.DeviceID != 0 {
+			sled, err := omeClient.GetDevice("", slot.DeviceID)
+			if err == nil {
+				status.SledServicetag = types.StringValue(sled.DeviceServiceTag)
+			}
+			status.ProfileApplied = types.BoolValue(profile.LastRunStatus == clients.SuccessStatusID)
+		}
+		existingTargets = append(existingTargets, slotTarget)
+		slotStatus = append(slotStatus, status)
+	}
+	return existingTargets, slotStatus, nil
+}
+
+// findSlotProfile returns the profile assigned to the slot, OME may report it against the sled held by the slot
+// once the profile is applied
+func findSlotProfile(serverProfiles models.OMEServerProfiles, slot models.OMEChassisSlot) *models.OMEServerProfile {
+	for i := range serverProfiles.Value {
+		if serverProfiles.Value[i].TargetID == slot.ID {
+			return &serverProfiles.Value[i]
+		}
+	}
+	if slot.DeviceID == 0 {
+		return nil
+	}
+	for i := range serverProfiles.Value {
+		if serverProfiles.Value[i].DeviceIDInSlot == slot.DeviceID {
+			return &serverProfiles.Value[i]
+		}
+	}
+	return nil
+}
+
+// updateSlotState saves the slot targets and their status into the state
+func updateSlotState(ctx context.Context, omeClient *clients.Client, stateTemplateDeployment *models.TemplateDeployment, slotTargets []models.SlotTarget, keepTargets bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(slotTargets) == 0 {
+		stateTemplateDeployment.SlotStatus = types.ListNull(slotStatusType)
+		return diags
+	}
+	serverProfiles, err := omeClient.GetServerProfileInfoByTemplateName(stateTemplateDeployment.TemplateName.ValueString())
+	if err != nil {
+		diags.AddError(clients.ErrTemplateDeploymentGeneral, err.Error())
+		return diags
+	}
+	existingTargets, slotStatus, err := refreshSlotStatus(omeClient, slotTargets, serverProfiles)
+	if err != nil {
+		diags.AddError(clients.ErrTemplateDeploymentGeneral, err.Error())
+		return diags
+	}
+	slotStatusTfsdk, d := types.ListValueFrom(ctx, slotStatusType, slotStatus)
+	diags.Append(d...)
+	stateTemplateDeployment.SlotStatus = slotStatusTfsdk
+	// after an apply the configured targets are kept, the next refresh drops the slots without a profile
+	if !keepTargets && !stateTemplateDeployment.SlotTargets.IsNull() {
+		slotTargetsTfsdk, d := types.SetValueFrom(ctx, slotTargetType, existingTargets)
+		diags.Append(d...)
+		stateTemplateDeployment.SlotTargets = slotTargetsTfsdk
+	}
+	return diags
+}
@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"terraform-provider-ome/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeploymentSlotTargets(t *testing.T) {
+	slotTarget := func(chassis string, slot int64) models.SlotTarget {
+		return models.SlotTarget{ChassisServicetag: types.StringValue(chassis), SlotNumber: types.Int64Value(slot)}
+	}
+	slotStatus := func(chassis string, slot, slotID, profileID int64) models.SlotStatus {
+		return models.SlotStatus{
+			ChassisServicetag: types.StringValue(chassis),
+			SlotNumber:        types.Int64Value(slot),
+			SlotID:            types.Int64Value(slotID),
+			ProfileID:         types.Int64Value(profileID),
+		}
+	}
+
+	state := []models.SlotStatus{slotStatus("MXCHAS1", 1, 20001, 10079), slotStatus("MXCHAS1", 2, 20002, 10080)}
+	newTargets, removeProfileIDs := diffSlotTargets([]models.SlotTarget{slotTarget("MXCHAS1", 2), slotTarget("MXCHAS2", 1)}, state)
+	assert.Equal(t, []models.SlotTarget{slotTarget("MXCHAS2", 1)}, newTargets)
+	assert.Equal(t, []int64{10079}, removeProfileIDs)
+
+	newTargets, removeProfileIDs = diffSlotTargets(nil, nil)
+	assert.Empty(t, newTargets)
+	assert.Empty(t, removeProfileIDs)
+
+	profiles := models.OMEServerProfiles{Value: []models.OMEServerProfile{{ID: 1, TargetID: 10001}, {ID: 10079, TargetID: 20001}}}
+	deviceProfiles := withoutSlotProfiles(profiles, profileIDSet(slotProfileIDs(state)))
+	assert.Equal(t, []models.OMEServerProfile{{ID: 1, TargetID: 10001}}, deviceProfiles.Value)
+
+	// once applied, the slot profile may be reported against the sled held by the slot
+	profiles = models.OMEServerProfiles{Value: []models.OMEServerProfile{{ID: 1, TargetID: 10001}, {ID: 10080, TargetID: 10002, DeviceIDInSlot: 10002}}}
+	deviceProfiles = withoutSlotProfiles(profiles, profileIDSet(slotProfileIDs(state)))
+	assert.Equal(t, []models.OMEServerProfile{{ID: 1, TargetID: 10001}}, deviceProfiles.Value)
+
+	profiles = models.OMEServerProfiles{Value: []models.OMEServerProfile{{ID: 1, TargetID: 10001}, {ID: 10081, TargetID: 30001, DeviceIDInSlot: 10003}}}
+	assert.Equal(t, int64(10081), findSlotProfile(profiles, models.OMEChassisSlot{ID: 20003, DeviceID: 10003}).ID)
+	assert.Nil(t, findSlotProfile(profiles, models.OMEChassisSlot{ID: 20004}))
+	assert.Nil(t, findSlotProfile(profiles, models.OMEChassisSlot{ID: 20001, DeviceID: 10001}))
+}
@@ -141,6 +141,34 @@ func TestTemplateDeploy_CreateAndUpdateDeploySuccess(t *testing.T) {
 	})
 }
 
+func TestTemplateDeploy_RolloutSuccess(t *testing.T) {
+	if skipTest() {
+		t.Skip(SkipTestMsg)
+	}
+	temp := initTemplates(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testTemplateDeploymentRolloutRunLater + temp.templateDeploySvcTag1,
+				ExpectError: regexp.MustCompile("rollout cannot be used with run_later"),
+			},
+			{
+				Config: testTemplateDeploymentRollout + temp.templateDeploySvcTag1,
+				Check: resource.ComposeTestCheckFunc(resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "device_servicetags.#", "2"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "rollout.batch_size", "1"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "deployment_results.#", "2"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "deployment_results.0.batch", "1"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "deployment_results.0.status", DeploymentStatusSuccess),
+					resource.TestCheckResourceAttrSet("ome_deployment.deploy-template-3", "deployment_results.0.profile_id"),
+					resource.TestCheckResourceAttr("ome_deployment.deploy-template-3", "deployment_results.1.batch", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestTemplateDeploy_CreateUpdateDeployWithScheduleSuccess(t *testing.T) {
 	if skipTest() {
 		t.Skip(SkipTestMsg)
@@ -364,6 +392,44 @@ var testTemplateUpdateDeploymentSuccess = `
 	}
 `
 
+var testTemplateDeploymentRollout = `
+	provider "ome" {
+		username = "` + omeUserName + `"
+		password = "` + omePassword + `"
+		host = "` + omeHost + `"
+		skipssl = true
+	}
+
+	resource "ome_deployment" "deploy-template-3" {
+		template_name = resource.ome_template.terraform-acceptance-test-1.name
+		device_servicetags = ["` + DeviceSvcTag1 + `", "` + DeviceSvcTag2 + `"]
+		rollout = {
+			batch_size = 1
+			pause_between_batches = 30
+			max_failures = 1
+		}
+	}
+`
+
+var testTemplateDeploymentRolloutRunLater = `
+	provider "ome" {
+		username = "` + omeUserName + `"
+		password = "` + omePassword + `"
+		host = "` + omeHost + `"
+		skipssl = true
+	}
+
+	resource "ome_deployment" "deploy-template-3" {
+		template_name = resource.ome_template.terraform-acceptance-test-1.name
+		device_servicetags = ["` + DeviceSvcTag1 + `"]
+		run_later = true
+		cron = "0 0 0 ? * * *"
+		rollout = {
+			batch_percentage = 50
+		}
+	}
+`
+
 var testTemplateDeploymentbootToNetworkISOSuccess = `
 	provider "ome" {
 		username = "` + omeUserName + `"
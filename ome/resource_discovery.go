@@ -210,6 +210,11 @@ func (r *discoveryResource) Read(ctx context.Context, req resource.ReadRequest,
 	id, _ := strconv.Atoi(state.DiscoveryJobID.ValueString())
 	respDiscovery, err := omeClient.GetDiscoveryJobByGroupID(int64(id))
 	if err != nil {
+		if clients.IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Unable to find discovery job (%v), clearing state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			clients.ErrGnrReadDiscovery, err.Error(),
 		)
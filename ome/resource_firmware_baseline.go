@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"terraform-provider-ome/clients"
 	"terraform-provider-ome/helper"
 	"terraform-provider-ome/models"
 	"time"
@@ -44,11 +45,7 @@ type resourceFirmwareBaseline struct {
 }
 
 const (
-	// BaselineRetryCount - stores the default value of retry count
-	BaselineRetryCount = 5
-	// BaselineSleepInterval - stores the default value of sleep interval
-	BaselineSleepInterval = 30
-	// BaselineSleepTimeBeforeJob - wait time in seconds before job tracking
+	// BaselineSleepTimeBeforeJob - wait time in seconds before the created or updated baseline is read
 	BaselineSleepTimeBeforeJob = 5
 )
 
@@ -87,6 +84,13 @@ func (r resourceFirmwareBaseline) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	if _, err := helper.GetFirmwareComponentRules(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(
+			`Invalid component rules for: `+plan.Name.ValueString()+``, err.Error(),
+		)
+		return
+	}
+
 	// defer the remove session
 	omeClient, ds := r.p.createOMESession(ctx, "resource_firmware_baseline Configure")
 	resp.Diagnostics.Append(ds...)
@@ -138,20 +142,9 @@ func (r resourceFirmwareBaseline) Create(ctx context.Context, req resource.Creat
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("Baseline created with id %d", jobID))
-	// Wait for the job to finish
+	// Give OME time to register the baseline before it is read
 	time.Sleep(BaselineSleepTimeBeforeJob * time.Second)
 
-	if jobID != 0 {
-		isSuccess, message := omeClient.TrackJob(jobID, BaselineRetryCount, BaselineSleepInterval)
-		if !isSuccess {
-			resp.Diagnostics.AddError(
-				"Create Baseline job for: "+plan.Name.ValueString()+" has some errors",
-				message,
-			)
-			return
-		}
-	}
-
 	// Get Firmware Baseline Data
 	omeBaselineData, errGet := helper.GetFirmwareBaselineWithName(*omeClient, plan.Name.ValueString())
 	if errGet != nil {
@@ -160,6 +153,12 @@ func (r resourceFirmwareBaseline) Create(ctx context.Context, req resource.Creat
 		)
 		return
 	}
+
+	// Wait for the job of the baseline, its compliance task, only when requested
+	var errWait error
+	if plan.WaitForCompletion.ValueBool() {
+		omeBaselineData, errWait = helper.WaitForBaselineComplianceTask(ctx, omeClient, omeBaselineData, jobID, firmwareTaskRetries(plan.Timeout), actionJobInterval)
+	}
 	// Set the tf state after Read
 	state, errCopy := helper.SetStateBaseline(ctx, omeBaselineData, plan)
 	state.DeviceNames = plan.DeviceNames
@@ -178,6 +177,13 @@ func (r resourceFirmwareBaseline) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// the baseline exists on OME, so it is kept in the state and tainted
+	if errWait != nil {
+		resp.Diagnostics.AddError(
+			"Create Baseline job for: "+plan.Name.ValueString()+" has some errors", errWait.Error(),
+		)
+		return
+	}
 	tflog.Trace(ctx, "resource_firmware_baseline create: finished")
 
 }
@@ -204,6 +210,11 @@ func (r resourceFirmwareBaseline) Read(ctx context.Context, req resource.ReadReq
 
 	omeBaselineData, err := helper.GetFirmwareBaselineWithID(*omeClient, curState.ID.ValueInt64())
 	if err != nil {
+		if clients.IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Unable to find firmware baseline (%v), clearing state", curState.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			`Could not Read Baseline: `+curState.Name.ValueString()+``, err.Error(),
 		)
@@ -249,6 +260,13 @@ func (r resourceFirmwareBaseline) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	if _, err := helper.GetFirmwareComponentRules(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(
+			`Invalid component rules for: `+plan.Name.ValueString()+``, err.Error(),
+		)
+		return
+	}
+
 	// Create Session and defer the remove session
 	omeClient, d := r.p.createOMESession(ctx, "resource_firmware_baseline Update")
 	resp.Diagnostics.Append(d...)
@@ -267,20 +285,9 @@ func (r resourceFirmwareBaseline) Update(ctx context.Context, req resource.Updat
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("Baseline Updated with id %d", jobID))
-	// Wait for the job to finish
+	// Give OME time to register the baseline before it is read
 	time.Sleep(BaselineSleepTimeBeforeJob * time.Second)
 
-	if jobID != 0 {
-		isSuccess, message := omeClient.TrackJob(jobID, BaselineRetryCount, BaselineSleepInterval)
-		if !isSuccess {
-			resp.Diagnostics.AddError(
-				"Update Baseline job for: "+plan.Name.ValueString()+" has some errors",
-				message,
-			)
-			return
-		}
-	}
-
 	omeBaselineData, err := helper.GetFirmwareBaselineWithName(*omeClient, plan.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -289,6 +296,12 @@ func (r resourceFirmwareBaseline) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	// Wait for the job of the baseline, its compliance task, only when requested
+	var errWait error
+	if plan.WaitForCompletion.ValueBool() {
+		omeBaselineData, errWait = helper.WaitForBaselineComplianceTask(ctx, omeClient, omeBaselineData, jobID, firmwareTaskRetries(plan.Timeout), actionJobInterval)
+	}
+
 	// Set the tf state after update
 	updState, errCopy := helper.SetStateBaseline(ctx, omeBaselineData, plan)
 	updState.DeviceNames = plan.DeviceNames
@@ -303,6 +316,11 @@ func (r resourceFirmwareBaseline) Update(ctx context.Context, req resource.Updat
 
 	diags := resp.State.Set(ctx, &updState)
 	resp.Diagnostics.Append(diags...)
+	if errWait != nil {
+		resp.Diagnostics.AddError(
+			"Update Baseline job for: "+plan.Name.ValueString()+" has some errors", errWait.Error(),
+		)
+	}
 
 	tflog.Trace(ctx, "resource_firmware_baseline: update end")
 }
@@ -14,6 +14,10 @@ limitations under the License.
 package ome
 
 import (
+	"fmt"
+	"terraform-provider-ome/clients"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -117,6 +121,40 @@ func FirmwareBaselineSchema() map[string]schema.Attribute {
 			Description:         "Task status.",
 			Computed:            true,
 		},
+		"wait_for_completion": schema.BoolAttribute{
+			MarkdownDescription: "Wait for completion. Waits for the compliance task of the baseline (`task_id`) to finish after the baseline is created or updated, so that `compliance_summary` is final and a failed task is reported as an error." +
+				" Set to false to return once the baseline is created or updated, without waiting for its compliance task.",
+			Description: "Wait for completion. Waits for the compliance task of the baseline ('task_id') to finish after the baseline is created or updated, so that 'compliance_summary' is final and a failed task is reported as an error." +
+				" Set to false to return once the baseline is created or updated, without waiting for its compliance task.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Bool{
+				BoolDefaultValue(types.BoolValue(true)),
+			},
+		},
+		"component_rules": schema.ListNestedAttribute{
+			MarkdownDescription: "Component rules pinning firmware components to a version or excluding them from the baseline, for components that must stay on a certified version when the catalog moves forward." +
+				" The first rule matching a component applies. The rules are only kept in the terraform state, they are not sent to OME and do not change the compliance computed by OME." +
+				" To apply them, pass `ome_firmware_baseline.<name>.component_rules` to the `component_rules` attribute of the `ome_firmware_baseline_compliance_report` and `ome_device_compliance_report` data sources.",
+			Description: "Component rules pinning firmware components to a version or excluding them from the baseline, for components that must stay on a certified version when the catalog moves forward." +
+				" The first rule matching a component applies. The rules are only kept in the terraform state, they are not sent to OME and do not change the compliance computed by OME." +
+				" To apply them, pass 'ome_firmware_baseline.<name>.component_rules' to the 'component_rules' attribute of the 'ome_firmware_baseline_compliance_report' and 'ome_device_compliance_report' data sources.",
+			Optional:     true,
+			NestedObject: schema.NestedAttributeObject{Attributes: FirmwareComponentRuleSchema()},
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+		},
+		"timeout": schema.Int64Attribute{
+			MarkdownDescription: "Timeout, in minutes, for waiting for the compliance task of the baseline." +
+				fmt.Sprintf(" Default value is `%d`.", defaultJobTimeout),
+			Description: "Timeout, in minutes, for waiting for the compliance task of the baseline." +
+				fmt.Sprintf(" Default value is '%d'.", defaultJobTimeout),
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
 		"catalog_name": schema.StringAttribute{
 			MarkdownDescription: "Name of the catalog",
 			Description:         "Name of the catalog",
@@ -167,6 +205,45 @@ func FirmwareBaselineSchema() map[string]schema.Attribute {
 	}
 }
 
+// FirmwareComponentRuleSchema returns the schema of a firmware component rule.
+func FirmwareComponentRuleSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"action": schema.StringAttribute{
+			MarkdownDescription: "Action of the rule. `Pin` keeps the component at `version`, `Exclude` leaves the component out of the baseline and reports it as EXCLUDED. Options are (Pin, Exclude).",
+			Description:         "Action of the rule. 'Pin' keeps the component at 'version', 'Exclude' leaves the component out of the baseline and reports it as EXCLUDED. Options are (Pin, Exclude).",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(clients.FirmwareRulePin, clients.FirmwareRuleExclude),
+			},
+		},
+		"source_name": schema.StringAttribute{
+			MarkdownDescription: "Source name of the component, for example `DCIM:INSTALLED#701__NIC.Integrated.1-1-1`. Case insensitive, `*` matches any characters. One of source_name or component_id is required.",
+			Description:         "Source name of the component, for example 'DCIM:INSTALLED#701__NIC.Integrated.1-1-1'. Case insensitive, '*' matches any characters. One of source_name or component_id is required.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("component_id")),
+			},
+		},
+		"component_id": schema.StringAttribute{
+			MarkdownDescription: "Component ID of the component, the target identifier in the compliance reports. One of source_name or component_id is required.",
+			Description:         "Component ID of the component, the target identifier in the compliance reports. One of source_name or component_id is required.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "Version the component is pinned to. Required for the action Pin.",
+			Description:         "Version the component is pinned to. Required for the action Pin.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+	}
+}
+
 // SingleComplianceSummarySchema returns a map of attribute types for the compliance summary.
 func SingleComplianceSummarySchema() map[string]attr.Type {
 	return map[string]attr.Type{
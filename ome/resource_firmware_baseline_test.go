@@ -14,15 +14,18 @@ limitations under the License.
 package ome
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
+	"terraform-provider-ome/clients"
 	"terraform-provider-ome/helper"
 	"terraform-provider-ome/models"
 	"testing"
 
 	. "github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 var localMocker *Mocker
@@ -73,6 +76,9 @@ func TestFirmwareBaselineResourceCreate(t *testing.T) {
 					resource.TestCheckResourceAttr(fimwareBaselineCreate, "name", FirmwareBaselineName),
 					resource.TestCheckResourceAttr(fimwareBaselineCreate, "filter_no_reboot_required", "false"),
 					resource.TestCheckResourceAttr(fimwareBaselineCreate, "targets.#", "1"),
+					resource.TestCheckResourceAttr(fimwareBaselineCreate, "wait_for_completion", "true"),
+					resource.TestCheckResourceAttr(fimwareBaselineCreate, "task_status", "Completed"),
+					resource.TestCheckResourceAttrSet(fimwareBaselineCreate, "compliance_summary.compliance_status"),
 				),
 			},
 			{
@@ -83,6 +89,8 @@ func TestFirmwareBaselineResourceCreate(t *testing.T) {
 					resource.TestCheckResourceAttr(fimwareBaselineCreate, "filter_no_reboot_required", "false"),
 					resource.TestCheckResourceAttr(fimwareBaselineCreate, "targets.#", "1"),
 					resource.TestCheckResourceAttr(fimwareBaselineCreate, "description", "test baseline updated"),
+					resource.TestCheckResourceAttr(fimwareBaselineCreate, "component_rules.#", "2"),
+					resource.TestCheckResourceAttr(fimwareBaselineCreate, "component_rules.1.action", "Exclude"),
 				),
 			},
 			// Import testing
@@ -150,6 +158,10 @@ func TestFirmwareBaselineResourceCreateError(t *testing.T) {
 				Config:      createFirmwareBaselineResourceError,
 				ExpectError: regexp.MustCompile(".*Invalid Attribute Combination.*"),
 			},
+			{
+				Config:      createFirmwareBaselineResourceRuleError,
+				ExpectError: regexp.MustCompile(".*Invalid component rules*"),
+			},
 			{
 				Config:      createFirmwareBaselineResourceError2,
 				ExpectError: regexp.MustCompile(".*Unable to create target model for*"),
@@ -235,6 +247,59 @@ func TestFirmwareBaselineResourceGetFailure(t *testing.T) {
 	})
 }
 
+func TestFirmwareBaselineResourceWaitFailure(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					if localMocker != nil {
+						localMocker.Release()
+					}
+					if localMocker2 != nil {
+						localMocker2.Release()
+					}
+					FunctionMocker = Mock(helper.CreateFirmwareBaseline, OptGeneric).Return(int64(0), nil).Build()
+					localMocker = Mock(helper.GetFirmwareBaselineWithName).Return(models.FirmwareBaselinesModel{}, nil).Build()
+					localMocker2 = Mock(helper.WaitForBaselineComplianceTask).Return(models.FirmwareBaselinesModel{}, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      createFirmwareBaselineDeviceResource,
+				ExpectError: regexp.MustCompile(".*Create Baseline job for*"),
+			},
+		},
+	})
+}
+
+func TestWaitForBaselineComplianceTask(t *testing.T) {
+	tracked := []int64{}
+	mocker := Mock((*clients.Client).TrackJobRun).To(func(_ *clients.Client, _ context.Context, jobID, _, _ int64, _ clients.JobTracking) error {
+		tracked = append(tracked, jobID)
+		return nil
+	}).Build()
+	defer mocker.UnPatch()
+
+	// the job returned when the baseline is created is its compliance task
+	taskID := int64(11)
+	_, err := helper.WaitForBaselineComplianceTask(context.Background(), &clients.Client{}, models.FirmwareBaselinesModel{TaskID: &taskID}, 11, 20, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{11}, tracked)
+
+	// the baseline reports another compliance task
+	tracked = []int64{}
+	taskID = 12
+	_, err = helper.WaitForBaselineComplianceTask(context.Background(), &clients.Client{}, models.FirmwareBaselinesModel{TaskID: &taskID}, 11, 20, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{11, 12}, tracked)
+}
+
 func TestFirmwareBaselineResourceImportFail(t *testing.T) {
 	var fimwareBaselineCreate = "ome_firmware_baseline.firmware_baseline"
 	resource.Test(t, resource.TestCase{
@@ -285,6 +350,19 @@ resource "ome_firmware_baseline" "firmware_baseline3" {
 	description = "test baseline"
   }
 `
+var createFirmwareBaselineResourceRuleError = testProvider + `
+resource "ome_firmware_baseline" "firmware_baseline" {
+	catalog_name = "` + Catalog1 + `"
+	device_service_tags = ["HRPB0M3"]
+	name =  "` + FirmwareBaseline2Name + `"
+	component_rules = [
+		{
+			action = "Pin"
+			source_name = "DCIM:INSTALLED#701__NIC.*"
+		}
+	]
+  }
+`
 var createFirmwareBaselineResourceError = testProvider + `
 resource "ome_firmware_baseline" "firmware_baseline" {
 	catalog_name = "` + Catalog1 + `"
@@ -334,6 +412,17 @@ resource "ome_firmware_baseline" "firmware_baseline" {
 	#is_64_bit = false
 	#filter_no_reboot_required = true
 	description = "test baseline updated"
+	component_rules = [
+		{
+			action = "Pin"
+			source_name = "DCIM:INSTALLED#701__NIC.*"
+			version = "22.5.7"
+		},
+		{
+			action = "Exclude"
+			component_id = "159"
+		}
+	]
   }
 `
 var createFirmwareBaselineDeviceResourceupd = testProvider + `
@@ -15,11 +15,14 @@ package ome
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"terraform-provider-ome/clients"
 	"terraform-provider-ome/helper"
 	"terraform-provider-ome/models"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -29,7 +32,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &networkSettingResource{}
+	_ resource.Resource               = &networkSettingResource{}
+	_ resource.ResourceWithModifyPlan = &firmwareCatalogResource{}
 )
 
 // NewFirmwareCatalogResource is a helper function to simplify the provider implementation.
@@ -65,6 +69,48 @@ func (r *firmwareCatalogResource) Schema(_ context.Context, _ resource.SchemaReq
 	}
 }
 
+// ModifyPlan tests the connection to the share of the catalog while planning when test_connection is Plan.
+func (r *firmwareCatalogResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.p == nil || r.p.clientOpt == nil {
+		return
+	}
+	var plan models.OmeSingleCatalogResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.TestConnection.ValueString() != helper.TestConnectionPlan {
+		return
+	}
+	// values known only after apply can not be tested yet
+	for _, value := range []types.String{plan.ShareType, plan.ShareAddress, plan.CatalogFilePath, plan.ShareUser, plan.SharePassword, plan.Domain} {
+		if value.IsUnknown() {
+			return
+		}
+	}
+
+	omeClient, d := r.p.createOMESession(ctx, "resource_firmware_catalog ModifyPlan")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	var valError error
+	if req.State.Raw.IsNull() {
+		valError = helper.TestCatalogConnection(omeClient, plan)
+	} else {
+		var state models.OmeSingleCatalogResource
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		valError = helper.ValidateCatalogUpdate(omeClient, plan, state)
+	}
+	if valError != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("share_address"), `Unable to use the catalog share, validation error: `, valError.Error(),
+		)
+	}
+}
+
 // Create implements resource.Resource.
 func (r *firmwareCatalogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Trace(ctx, "firmwareCatalogResource: create started")
@@ -84,7 +130,7 @@ func (r *firmwareCatalogResource) Create(ctx context.Context, req resource.Creat
 
 	defer omeClient.RemoveSession()
 
-	valError := helper.ValidateCatalogCreate(plan)
+	valError := helper.ValidateCatalogCreate(omeClient, plan)
 
 	if valError != nil {
 		resp.Diagnostics.AddError(
@@ -106,6 +152,11 @@ func (r *firmwareCatalogResource) Create(ctx context.Context, req resource.Creat
 	// Adding small timeout because catalog ID is not available in read operation otherwise, so AT and FT were failing.
 	time.Sleep(5 * time.Second)
 
+	var waitErr error
+	if plan.WaitForCompletion.ValueBool() {
+		cat, waitErr = helper.WaitForCatalogTask(ctx, omeClient, cat, plan.Name.ValueString(), nil, firmwareTaskRetries(plan.Timeout), actionJobInterval)
+	}
+
 	// Set the tf state after create
 	state, mapErr := helper.SetStateCatalogFirmware(ctx, cat, plan)
 	if mapErr != nil {
@@ -118,6 +169,13 @@ func (r *firmwareCatalogResource) Create(ctx context.Context, req resource.Creat
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 
+	// the catalog exists on OME, so it is kept in the state and tainted
+	if waitErr != nil {
+		resp.Diagnostics.AddError(
+			`Catalog `+plan.Name.ValueString()+` was created but could not be downloaded`, waitErr.Error(),
+		)
+	}
+
 	tflog.Trace(ctx, "firmwareCatalogResource: create end")
 }
 
@@ -144,6 +202,11 @@ func (r *firmwareCatalogResource) Read(ctx context.Context, req resource.ReadReq
 	// The only way to get the true id is to get all of the catalogs and find the one that matches by name (names are required to be unique for catalogs)
 	if currentState.ID.ValueInt64() == 0 {
 		id, idErr := helper.GetIDFromNameFirmwareCatalog(omeClient, currentState.Name.ValueString())
+		if clients.IsNotFound(idErr) {
+			tflog.Info(ctx, fmt.Sprintf("Unable to find firmware catalog (%v), clearing state", currentState.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		if idErr != nil {
 			resp.Diagnostics.AddError(
 				`Unable to read catalog id after create: `+currentState.Name.ValueString()+`.`, idErr.Error(),
@@ -155,9 +218,15 @@ func (r *firmwareCatalogResource) Read(ctx context.Context, req resource.ReadReq
 
 	cat, err := helper.GetSpecificCatalogFirmware(omeClient, currentState.ID.ValueInt64())
 	if err != nil {
+		if clients.IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Unable to find firmware catalog (%v), clearing state", currentState.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			`Unable to read specific firmware catalog: `+currentState.Name.ValueString()+``, err.Error(),
 		)
+		return
 	}
 
 	// Set the tf state after read
@@ -230,15 +299,6 @@ func (r *firmwareCatalogResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	valError := helper.ValidateCatalogUpdate(plan, state)
-
-	if valError != nil {
-		resp.Diagnostics.AddError(
-			`Unable to update catalog, validation error: `, valError.Error(),
-		)
-		return
-	}
-
 	// Create Session and defer the remove session
 	omeClient, d := r.p.createOMESession(ctx, "resource_firmware_catalog Update")
 	resp.Diagnostics.Append(d...)
@@ -247,6 +307,15 @@ func (r *firmwareCatalogResource) Update(ctx context.Context, req resource.Updat
 	}
 	defer omeClient.RemoveSession()
 
+	valError := helper.ValidateCatalogUpdate(omeClient, plan, state)
+
+	if valError != nil {
+		resp.Diagnostics.AddError(
+			`Unable to update catalog, validation error: `, valError.Error(),
+		)
+		return
+	}
+
 	repo := models.CatalogRepository{}
 	repoDiags := state.Repository.As(ctx, &repo, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true})
 	resp.Diagnostics.Append(repoDiags...)
@@ -256,6 +325,14 @@ func (r *firmwareCatalogResource) Update(ctx context.Context, req resource.Updat
 
 	updateModel := helper.MakeCatalogJSONModel(state.ID.ValueInt64(), repo.ID.ValueInt64(), plan)
 
+	// the download task keeps the status of its previous run till the update starts a new run
+	var lastRun *string
+	if plan.WaitForCompletion.ValueBool() && state.TaskID.ValueInt64() > 0 {
+		if task, err := omeClient.GetJob(state.TaskID.ValueInt64()); err == nil {
+			lastRun = &task.LastRun
+		}
+	}
+
 	cat, err := helper.UpdateCatalogFirmware(omeClient, state.ID.ValueInt64(), updateModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -264,6 +341,11 @@ func (r *firmwareCatalogResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	var waitErr error
+	if plan.WaitForCompletion.ValueBool() {
+		cat, waitErr = helper.WaitForCatalogTask(ctx, omeClient, cat, plan.Name.ValueString(), lastRun, firmwareTaskRetries(plan.Timeout), actionJobInterval)
+	}
+
 	// Update tf state after update of catalog
 	finalState, mapErr := helper.SetStateCatalogFirmware(ctx, cat, plan)
 	if mapErr != nil {
@@ -275,6 +357,12 @@ func (r *firmwareCatalogResource) Update(ctx context.Context, req resource.Updat
 	diags := resp.State.Set(ctx, &finalState)
 	resp.Diagnostics.Append(diags...)
 
+	if waitErr != nil {
+		resp.Diagnostics.AddError(
+			`Catalog `+plan.Name.ValueString()+` was updated but could not be downloaded`, waitErr.Error(),
+		)
+	}
+
 	tflog.Trace(ctx, "firmwareCatalogResource: update end")
 }
 
@@ -314,3 +402,12 @@ func (r *firmwareCatalogResource) ImportState(ctx context.Context, req resource.
 	resp.Diagnostics.Append(diags...)
 	tflog.Trace(ctx, "firmwareCatalogResource: import state end")
 }
+
+// firmwareTaskRetries returns the number of times the task of a catalog or baseline is polled within the timeout in minutes
+func firmwareTaskRetries(timeout types.Int64) int64 {
+	timeoutMinutes := defaultJobTimeout
+	if !timeout.IsNull() && !timeout.IsUnknown() {
+		timeoutMinutes = timeout.ValueInt64()
+	}
+	return timeoutMinutes * 60 / actionJobInterval
+}
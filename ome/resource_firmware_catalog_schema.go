@@ -14,12 +14,15 @@ limitations under the License.
 package ome
 
 import (
+	"fmt"
 	"regexp"
+	"terraform-provider-ome/helper"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -189,6 +192,37 @@ func FirmwareCatalogSchema() map[string]schema.Attribute {
 				),
 			},
 		},
+		"test_connection": schema.StringAttribute{
+			MarkdownDescription: "Test Connection. Sets when OME tests that it can read `catalog_file_path` from `share_address` with the given credentials, so that an unreachable share, rejected credentials or a wrong path are reported before the catalog is created or changed." +
+				" `Apply` tests before the catalog is created or its share changes, `Plan` also tests while planning, `Never` skips the test. Not used for the share_type DELL_ONLINE. Defaults to Apply. Options are (Apply, Plan, Never).",
+			Description: "Test Connection. Sets when OME tests that it can read 'catalog_file_path' from 'share_address' with the given credentials, so that an unreachable share, rejected credentials or a wrong path are reported before the catalog is created or changed." +
+				" 'Apply' tests before the catalog is created or its share changes, 'Plan' also tests while planning, 'Never' skips the test. Not used for the share_type DELL_ONLINE. Defaults to Apply. Options are (Apply, Plan, Never).",
+			Default:  stringdefault.StaticString(helper.TestConnectionApply),
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(helper.TestConnectionApply, helper.TestConnectionPlan, helper.TestConnectionNever),
+			},
+		},
+		"wait_for_completion": schema.BoolAttribute{
+			MarkdownDescription: "Wait For Completion. Waits for the task downloading the catalog (`task_id`) to finish after the catalog is created or updated, so that `status` and `last_update` are final and a failed download is reported as an error." +
+				" Set to false to return as soon as OME accepts the catalog. Defaults to true.",
+			Description: "Wait For Completion. Waits for the task downloading the catalog ('task_id') to finish after the catalog is created or updated, so that 'status' and 'last_update' are final and a failed download is reported as an error." +
+				" Set to false to return as soon as OME accepts the catalog. Defaults to true.",
+			Default:  booldefault.StaticBool(true),
+			Optional: true,
+			Computed: true,
+		},
+		"timeout": schema.Int64Attribute{
+			MarkdownDescription: "Timeout, in minutes, for waiting for the task downloading the catalog." +
+				fmt.Sprintf(" Default value is `%d`.", defaultJobTimeout),
+			Description: "Timeout, in minutes, for waiting for the task downloading the catalog." +
+				fmt.Sprintf(" Default value is '%d'.", defaultJobTimeout),
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
 
 		"associated_baselines": schema.ListNestedAttribute{
 			MarkdownDescription: "Associated Baselines.",
@@ -16,6 +16,7 @@ package ome
 import (
 	"fmt"
 	"regexp"
+	"terraform-provider-ome/clients"
 	"terraform-provider-ome/helper"
 	"terraform-provider-ome/models"
 	"testing"
@@ -95,6 +96,15 @@ func TestFirmwareCatalogResourceValidationCreateError(t *testing.T) {
 				Config:      createFirmwareCatalogResourceValidateErrorHTTPS,
 				ExpectError: regexp.MustCompile(".*invalid HTTPS share configuration.*"),
 			},
+			{
+				Config:      createFirmwareCatalogResourceConnectionErrorPlan,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(".*can not be read from the NFS share.*"),
+			},
+			{
+				Config:      createFirmwareCatalogResourceConnectionError,
+				ExpectError: regexp.MustCompile(".*can not be read from the NFS share.*"),
+			},
 		},
 	})
 }
@@ -166,6 +176,47 @@ func TestFirmwareCatalogResourceReadCreateUpdateErrors(t *testing.T) {
 	})
 }
 
+func TestFirmwareCatalogResourceWaitError(t *testing.T) {
+	createMock := models.CatalogsModel{
+		ID:     1,
+		Status: "Failed",
+		TaskID: 2,
+		Repository: models.RepositoryModel{
+			Name:           CatalogResource,
+			RepositoryType: "HTTPS",
+			Source:         "https://1.2.2.1",
+		},
+	}
+	var readMocker *Mocker
+	defer func() {
+		if readMocker != nil {
+			readMocker.UnPatch()
+		}
+	}()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					if localFunctionalMocker != nil {
+						localFunctionalMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.CreateCatalogFirmware, OptGeneric).Return(createMock, nil).Build()
+					localFunctionalMocker = Mock(helper.WaitForCatalogTask, OptGeneric).Return(createMock, fmt.Errorf("Mock error")).Build()
+					// the catalog is gone when it is refreshed before the destroy
+					readMocker = Mock(helper.GetSpecificCatalogFirmware, OptGeneric).Return(nil, clients.ErrItemNotFound).Build()
+				},
+				Config:      createFirmwareCatalogResourceWait,
+				ExpectError: regexp.MustCompile(`.*was created but could not be downloaded*.`),
+			},
+		},
+	})
+}
+
 var updateFirmwareMockError = testProvider + `
 	resource "ome_firmware_catalog" "cat_1" {
 		name = "` + CatalogResource + `_update"
@@ -182,6 +233,8 @@ var updateFirmwareMockError = testProvider + `
         domain = "example"
         share_user = "example-user"
         share_password = "example-pass"
+        test_connection = "Never"
+        wait_for_completion = false
 	}
 `
 
@@ -240,6 +293,8 @@ var createFirmwareCatalogResource = testProvider + `
         domain = "example"
         share_user = "example-user"
         share_password = "example-pass"
+        test_connection = "Never"
+        wait_for_completion = false
     }
 `
 
@@ -259,5 +314,40 @@ resource "ome_firmware_catalog" "cat_1" {
     domain = "example_update"
     share_user = "example-user_update"
     share_password = "example-pass_update"
+    test_connection = "Never"
+    wait_for_completion = false
 }
 `
+
+var createFirmwareCatalogResourceWait = testProvider + `
+	resource "ome_firmware_catalog" "cat_1" {
+		name = "` + CatalogResource + `"
+		catalog_update_type = "Manual"
+		share_type = "HTTPS"
+		catalog_file_path = "catalogs/example_catalog_1.xml"
+		share_address = "https://1.2.2.1"
+		test_connection = "Never"
+		timeout = 1
+	}
+`
+
+var createFirmwareCatalogResourceConnectionError = testProvider + `
+	resource "ome_firmware_catalog" "cat_1" {
+		name = "` + CatalogResource + `_validate"
+		catalog_update_type = "Manual"
+		share_type = "NFS"
+		share_address = "192.0.2.1"
+		catalog_file_path = "catalogs/Catalog.xml"
+	}
+`
+
+var createFirmwareCatalogResourceConnectionErrorPlan = testProvider + `
+	resource "ome_firmware_catalog" "cat_1" {
+		name = "` + CatalogResource + `_validate"
+		catalog_update_type = "Manual"
+		share_type = "NFS"
+		share_address = "192.0.2.1"
+		catalog_file_path = "catalogs/Catalog.xml"
+		test_connection = "Plan"
+	}
+`
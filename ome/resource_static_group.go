@@ -15,6 +15,7 @@ package ome
 
 import (
 	"context"
+	"fmt"
 	"terraform-provider-ome/clients"
 	"terraform-provider-ome/models"
 
@@ -196,6 +197,12 @@ func (r resourceStaticGroup) Read(ctx context.Context, req resource.ReadRequest,
 
 	tflog.Trace(ctx, "resource_static_group read: client created started updating state")
 
+	if _, err := omeClient.GetGroupByID(state.ID.ValueInt64()); clients.IsNotFound(err) {
+		tflog.Info(ctx, fmt.Sprintf("Unable to find group (%v), clearing state", state.ID.ValueInt64()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	finalState, dgs := r.ReadRes(omeClient, state.ID.ValueInt64())
 	resp.Diagnostics.Append(dgs...)
 	if dgs.HasError() {
@@ -23,8 +23,10 @@ import (
 	"terraform-provider-ome/models"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -58,9 +60,11 @@ const (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &resourceTemplate{}
-	_ resource.ResourceWithConfigure   = &resourceTemplate{}
-	_ resource.ResourceWithImportState = &resourceTemplate{}
+	_ resource.Resource                   = &resourceTemplate{}
+	_ resource.ResourceWithConfigure      = &resourceTemplate{}
+	_ resource.ResourceWithImportState    = &resourceTemplate{}
+	_ resource.ResourceWithModifyPlan     = &resourceTemplate{}
+	_ resource.ResourceWithValidateConfig = &resourceTemplate{}
 )
 
 // NewTemplateResource is new resource for template
@@ -148,16 +152,17 @@ func (r *resourceTemplate) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:            true,
 			},
 			"device_type": schema.StringAttribute{
-				MarkdownDescription: "OME template device type, supported types are Server, Chassis. Cannot be updated and is applicable only for importing xml." +
+				MarkdownDescription: "OME template device type, supported types are Server, Chassis. Is applicable only for importing xml, changing it replaces the template." +
 					" Valid values are `Server` and `Chassis`." +
 					" Default value is `Server`.",
-				Description: "OME template device type, supported types are Server, Chassis. Cannot be updated and is applicable only for importing xml." +
+				Description: "OME template device type, supported types are Server, Chassis. Is applicable only for importing xml, changing it replaces the template." +
 					" Valid values are 'Server' and 'Chassis'." +
 					" Default value is 'Server'.",
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					StringDefaultValue(types.StringValue("Server")),
+					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					stringvalidator.OneOf(
@@ -167,10 +172,24 @@ func (r *resourceTemplate) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"content": schema.StringAttribute{
-				MarkdownDescription: "The XML content of template. Cannot be updated.",
-				Description:         "The XML content of template. Cannot be updated.",
-				Optional:            true,
-				Computed:            true,
+				MarkdownDescription: "The XML content of template." +
+					" Changes of attribute values are applied to the template in place, adding or removing attributes or components, or changing attributes which can not be matched to one attribute of the template, replaces the template." +
+					" Cannot be set on templates created from a reference device or template." +
+					" The content is validated at plan time, whitespaces, ordering of components and attributes and the export timestamp are not differences.",
+				Description: "The XML content of template." +
+					" Changes of attribute values are applied to the template in place, adding or removing attributes or components, or changing attributes which can not be matched to one attribute of the template, replaces the template." +
+					" Cannot be set on templates created from a reference device or template." +
+					" The content is validated at plan time, whitespaces, ordering of components and attributes and the export timestamp are not differences.",
+				Optional:   true,
+				Computed:   true,
+				CustomType: models.SCPContentType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						templateContentRequiresReplace,
+						"Adding or removing attributes or components of the content replaces the template.",
+						"Adding or removing attributes or components of the content replaces the template.",
+					),
+				},
 			},
 			"refdevice_servicetag": schema.StringAttribute{
 				MarkdownDescription: "Target device servicetag from which the template needs to be created. Cannot be updated.",
@@ -216,6 +235,22 @@ func (r *resourceTemplate) Schema(_ context.Context, _ resource.SchemaRequest, r
 					},
 				},
 			},
+			"attribute_values": schema.MapAttribute{
+				MarkdownDescription: "Values of the attributes of the template keyed by their path, made of the names of the attribute groups and the name of the attribute separated by commas" +
+					" like `iDRAC,Time Zone Configuration Information,Time Zone String 1`." +
+					" The values are applied when creating and updating the template." +
+					" Conflicts with `attributes`.",
+				Description: "Values of the attributes of the template keyed by their path, made of the names of the attribute groups and the name of the attribute separated by commas" +
+					" like 'iDRAC,Time Zone Configuration Information,Time Zone String 1'." +
+					" The values are applied when creating and updating the template." +
+					" Conflicts with 'attributes'.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.ConflictsWith(path.MatchRoot("attributes")),
+				},
+			},
 			"job_retry_count": schema.Int64Attribute{
 				MarkdownDescription: "Number of times the job has to be polled to get the final status of the resource." +
 					fmt.Sprintf(" Default value is `%d`.", RetryCount),
@@ -479,6 +514,24 @@ func (r *resourceTemplate) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	if len(plan.AttributeValues.Elements()) > 0 {
+		tflog.Info(ctx, "resource_template create: applying attribute values")
+		omeAttributes, err = applyAttributeValues(ctx, omeClient, omeTemplateData, plan.AttributeValues)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				clients.ErrCreateTemplate, err.Error(),
+			)
+			_, err = omeClient.Delete(fmt.Sprintf(clients.TemplateAPI+"(%d)", templateID), nil, nil)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					clients.ErrCreateTemplate,
+					err.Error(),
+				)
+			}
+			return
+		}
+	}
+
 	tflog.Trace(ctx, "resource_template create: fetching template valn data")
 	omeVlan, err := omeClient.GetSchemaVlanData(templateID)
 	if err != nil {
@@ -511,6 +564,7 @@ func (r *resourceTemplate) Create(ctx context.Context, req resource.CreateReques
 	if !plan.Content.IsUnknown() {
 		template.Content = plan.Content
 	}
+	template.AttributeValues = plan.AttributeValues
 	if !plan.ViewType.IsUnknown() {
 		template.ViewType = plan.ViewType
 	}
@@ -589,7 +643,7 @@ func (r *resourceTemplate) Read(ctx context.Context, req resource.ReadRequest, r
 		// If status code is 400 during a read, that means the ID is no longer valid
 		// clear state and create again
 		tflog.Info(ctx, fmt.Sprintf("httpStatus Code: %v", httpResponse.StatusCode))
-		if httpResponse.StatusCode == 400 {
+		if httpResponse.StatusCode == 400 || clients.IsNotFound(err) {
 			tflog.Info(ctx, fmt.Sprintf("Unable to find id (%v), clearing state", templateID))
 			resp.State.RemoveResource(ctx)
 			return
@@ -651,6 +705,11 @@ func (r *resourceTemplate) Read(ctx context.Context, req resource.ReadRequest, r
 	stateVlan.VlanAttributes.ElementsAs(ctx, &vlanAttrs, true)
 
 	updateState(&template, vlanAttrs, &omeTemplateData, omeAttributes, omeVlan)
+	attributeValues, warnings := refreshAttributeValues(template.AttributeValues, omeAttributes)
+	for _, warning := range warnings {
+		resp.Diagnostics.AddWarning(clients.ErrReadTemplate, warning)
+	}
+	template.AttributeValues = attributeValues
 
 	tflog.Trace(ctx, "resource_template read: updating state finished")
 
@@ -688,7 +747,7 @@ func (r resourceTemplate) Update(ctx context.Context, req resource.UpdateRequest
 	if isConfigValuesChanged(planTemplate, stateTemplate) {
 		resp.Diagnostics.AddError(
 			clients.ErrUpdateTemplate,
-			"cannot update the following fields : `refdevice_servicetag`,`refdevice_id`,`view_type`, `reftemplate_name`, `content` and `fqdds`",
+			"cannot update the following fields : `refdevice_servicetag`,`refdevice_id`,`view_type`, `reftemplate_name`, `fqdds` and `content` of templates not imported from a xml content",
 		)
 		return
 	}
@@ -759,6 +818,36 @@ func (r resourceTemplate) Update(ctx context.Context, req resource.UpdateRequest
 		updatePayload.Attributes = da
 	}
 
+	if isTemplateContentUpdated(planTemplate.Content, stateTemplate.Content) {
+		contentChanges, unmatched, err := getTemplateContentChanges(ctx, omeClient, templateID, planTemplate.Content.ValueString(), stateTemplate.Content.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				clients.ErrUpdateTemplate, err.Error(),
+			)
+			return
+		}
+		if len(unmatched) > 0 {
+			resp.Diagnostics.AddError(
+				clients.ErrUpdateTemplate, "unable to apply the content to the template: "+strings.Join(unmatched, "\n"),
+			)
+			return
+		}
+		updatePayload.Attributes = append(updatePayload.Attributes, contentChanges...)
+		stateTemplate.Content = planTemplate.Content
+	}
+
+	if !planTemplate.AttributeValues.IsNull() && !planTemplate.AttributeValues.Equal(stateTemplate.AttributeValues) {
+		valueChanges, err := getAttributeValueChanges(ctx, omeClient, templateID, planTemplate.AttributeValues)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				clients.ErrUpdateTemplate, err.Error(),
+			)
+			return
+		}
+		updatePayload.Attributes = append(updatePayload.Attributes, valueChanges...)
+	}
+	stateTemplate.AttributeValues = planTemplate.AttributeValues
+
 	tflog.Trace(ctx, "resource_template update: started a call to update template")
 	err = omeClient.UpdateTemplate(updatePayload)
 	if err != nil {
@@ -1025,7 +1114,8 @@ func (r resourceTemplate) ImportState(ctx context.Context, req resource.ImportSt
 	template.RefdeviceID = types.Int64Value(omeTemplateData.SourceDeviceID)
 	template.RefdeviceServicetag = types.StringValue("NA")
 	template.ReftemplateName = types.StringValue("NA")
-	template.Content = types.StringValue("NA")
+	template.Content = models.NewSCPContentValue("NA")
+	template.AttributeValues = types.MapNull(types.StringType)
 	template.ViewType = types.StringValue(viewType)
 	template.DeviceType = types.StringValue(deviceType)
 	template.JobRetryCount = types.Int64Value(RetryCount)
@@ -1098,7 +1188,7 @@ func isConfigValuesChanged(planTemplate, stateTemplate models.Template) bool {
 		(!planTemplate.ViewType.IsUnknown() && stateTemplate.ViewType.ValueString() != planTemplate.ViewType.ValueString()) ||
 		(!planTemplate.FQDDS.IsUnknown() && stateTemplate.FQDDS.ValueString() != planTemplate.FQDDS.ValueString()) ||
 		(!planTemplate.ReftemplateName.IsUnknown() && stateTemplate.ReftemplateName.ValueString() != planTemplate.ReftemplateName.ValueString()) ||
-		(!planTemplate.Content.IsUnknown() && stateTemplate.Content.ValueString() != planTemplate.Content.ValueString())
+		(!planTemplate.Content.IsUnknown() && stateTemplate.Content.ValueString() == "" && planTemplate.Content.ValueString() != "")
 }
 
 func validateVlan(planVlan, remoteVlan models.OMEVlan, vlanNetworks []models.VLanNetworks) error {
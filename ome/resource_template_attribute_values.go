@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// getAttributeValueChanges returns the changes of the template attributes whose values differ from the given values
+func getAttributeValueChanges(ctx context.Context, omeClient *clients.Client, templateID int64, attributeValues types.Map) ([]models.UpdateAttribute, error) {
+	values := map[string]string{}
+	for attributePath, value := range attributeValues.Elements() {
+		values[attributePath] = value.(types.String).ValueString()
+	}
+	paths := make([]string, 0, len(values))
+	for attributePath := range values {
+		paths = append(paths, attributePath)
+	}
+	sort.Strings(paths)
+
+	attributes, err := omeClient.GetTemplateAttributesByPath(templateID, paths)
+	if err != nil {
+		return nil, err
+	}
+	changes := []models.UpdateAttribute{}
+	for _, attributePath := range paths {
+		attribute := attributes[attributePath]
+		if attribute.Value != values[attributePath] || attribute.IsIgnored {
+			changes = append(changes, models.UpdateAttribute{
+				ID:        attribute.AttributeID,
+				Value:     values[attributePath],
+				IsIgnored: false,
+			})
+		}
+	}
+	tflog.Debug(ctx, "resource_template: attribute value changes", map[string]interface{}{
+		"changes": len(changes),
+	})
+	return changes, nil
+}
+
+// applyAttributeValues updates the attributes of a created template to the given values and returns the refreshed attributes
+func applyAttributeValues(ctx context.Context, omeClient *clients.Client, omeTemplateData models.OMETemplate, attributeValues types.Map) ([]models.OmeAttribute, error) {
+	changes, err := getAttributeValueChanges(ctx, omeClient, omeTemplateData.ID, attributeValues)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) > 0 {
+		err = omeClient.UpdateTemplate(models.UpdateTemplate{
+			ID:          omeTemplateData.ID,
+			Name:        omeTemplateData.Name,
+			Description: omeTemplateData.Description,
+			Attributes:  changes,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return omeClient.GetTemplateAttributes(omeTemplateData.ID, []models.Attribute{}, true)
+}
+
+// refreshAttributeValues returns the attribute values with the values of the template attributes.
+// A path shared by several attributes of the template is ambiguous, its prior value is kept and a warning listing the attributes is returned.
+func refreshAttributeValues(attributeValues types.Map, omeAttributes []models.OmeAttribute) (types.Map, []string) {
+	warnings := []string{}
+	if attributeValues.IsNull() || attributeValues.IsUnknown() {
+		return attributeValues, warnings
+	}
+	values := map[string]attr.Value{}
+	for attributePath, value := range attributeValues.Elements() {
+		values[attributePath] = value
+		matches := []models.OmeAttribute{}
+		for _, attribute := range omeAttributes {
+			if attribute.DisplayName == attributePath {
+				matches = append(matches, attribute)
+			}
+		}
+		switch len(matches) {
+		case 0:
+		case 1:
+			values[attributePath] = types.StringValue(matches[0].Value)
+		default:
+			candidates := make([]string, 0, len(matches))
+			for _, attribute := range matches {
+				candidates = append(candidates, fmt.Sprintf("%d (%s)", attribute.AttributeID, attribute.Value))
+			}
+			warnings = append(warnings, fmt.Sprintf("%s matches several attributes of the template: %s, the value of the path is not refreshed", attributePath, strings.Join(candidates, ", ")))
+		}
+	}
+	sort.Strings(warnings)
+	return types.MapValueMust(types.StringType, values), warnings
+}
@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-ome/clients"
+	"terraform-provider-ome/models"
+	"terraform-provider-ome/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// templateContentRequiresReplace replaces the template when the attributes or components of its content are added or removed
+func templateContentRequiresReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.ValueString() == "" || req.PlanValue.IsUnknown() || req.PlanValue.ValueString() == "" {
+		return
+	}
+	oldSCP, err := utils.ParseSCP(req.StateValue.ValueString())
+	if err != nil {
+		// the content of imported templates is not known, the changes are checked while updating
+		return
+	}
+	newSCP, err := utils.ParseSCP(req.PlanValue.ValueString())
+	if err != nil {
+		return
+	}
+	diff := utils.DiffSCP(oldSCP, newSCP)
+	tflog.Debug(ctx, "resource_template plan: content differences", map[string]interface{}{
+		"added":   diff.Added,
+		"removed": diff.Removed,
+		"changed": len(diff.Changed),
+	})
+	resp.RequiresReplace = diff.IsStructural()
+}
+
+// ValidateConfig checks the XML content of the template before it is imported
+func (r *resourceTemplate) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var content models.SCPContent
+	var deviceType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content"), &content)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("device_type"), &deviceType)...)
+	if resp.Diagnostics.HasError() || content.IsNull() || content.IsUnknown() || deviceType.IsUnknown() {
+		return
+	}
+	templateDeviceType := "Server"
+	if !deviceType.IsNull() {
+		templateDeviceType = deviceType.ValueString()
+	}
+	resp.Diagnostics.Append(validateTemplateContent(content.ValueString(), templateDeviceType, path.Root("content"))...)
+}
+
+// ModifyPlan marks the attributes of the template as unknown when its content or attribute values are updated in place
+func (r *resourceTemplate) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var planContent, stateContent models.SCPContent
+	var planAttributes, configAttributes types.List
+	var planAttributeValues, stateAttributeValues types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("content"), &planContent)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("content"), &stateContent)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("attribute_values"), &planAttributeValues)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("attribute_values"), &stateAttributeValues)...)
+	if resp.Diagnostics.HasError() || resp.RequiresReplace.Contains(path.Root("content")) {
+		return
+	}
+	// a reformatted or reordered content holds the same SCP, keep the content of the state so that no update is planned
+	if !planContent.IsUnknown() && planContent.ValueString() != "" && stateContent.ValueString() != "" &&
+		planContent.ValueString() != stateContent.ValueString() && utils.EquivalentSCP(planContent.ValueString(), stateContent.ValueString()) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content"), stateContent)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		planContent = stateContent
+	}
+	contentUpdated := isTemplateContentUpdated(planContent, stateContent)
+	if contentUpdated {
+		replace, d := r.contentRequiresReplace(ctx, req, planContent, stateContent)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if replace {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content"))
+			return
+		}
+	}
+	valuesUpdated := !planAttributeValues.IsNull() && !planAttributeValues.Equal(stateAttributeValues)
+	if !contentUpdated && !valuesUpdated {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("attributes"), &configAttributes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if contentUpdated && !configAttributes.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("attributes"),
+			clients.ErrUpdateTemplate,
+			"`attributes` cannot be updated along with `content`, remove `attributes` from the configuration to update the content",
+		)
+		return
+	}
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("attributes"), &planAttributes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("attributes"), types.ListUnknown(planAttributes.ElementType(ctx)))...)
+}
+
+// contentRequiresReplace returns true when a changed attribute of the content can not be matched to one attribute of the template,
+// the content can then only be applied by importing it into a new template
+func (r *resourceTemplate) contentRequiresReplace(ctx context.Context, req resource.ModifyPlanRequest, planContent, stateContent models.SCPContent) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var id types.String
+	diags.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if diags.HasError() || r.p == nil || r.p.clientOpt == nil {
+		return false, diags
+	}
+	templateID, err := strconv.ParseInt(id.ValueString(), 10, 64)
+	if err != nil {
+		diags.AddError(clients.ErrUpdateTemplate, err.Error())
+		return false, diags
+	}
+	omeClient, d := r.p.createOMESession(ctx, "resource_template ModifyPlan")
+	diags.Append(d...)
+	if d.HasError() {
+		return false, diags
+	}
+	defer omeClient.RemoveSession()
+
+	_, unmatched, err := getTemplateContentChanges(ctx, omeClient, templateID, planContent.ValueString(), stateContent.ValueString())
+	if err != nil {
+		diags.AddError(clients.ErrUpdateTemplate, err.Error())
+		return false, diags
+	}
+	if len(unmatched) > 0 {
+		tflog.Info(ctx, "resource_template plan: the content changes can not be applied in place, the template is replaced", map[string]interface{}{
+			"unmatched": unmatched,
+		})
+	}
+	return len(unmatched) > 0, diags
+}
+
+// isTemplateContentUpdated returns true when the content of a template imported from a xml content holds a different SCP
+func isTemplateContentUpdated(planContent, stateContent models.SCPContent) bool {
+	return !planContent.IsUnknown() && planContent.ValueString() != "" &&
+		stateContent.ValueString() != "" && !utils.EquivalentSCP(planContent.ValueString(), stateContent.ValueString())
+}
+
+// getTemplateContentChanges returns the attribute changes bringing the template from the content of the state to the planned content.
+// Only the attributes changed between both contents are updated, the changes made outside of the content are kept.
+// The changed attributes which can not be matched to one attribute of the template are returned as unmatched.
+func getTemplateContentChanges(ctx context.Context, omeClient *clients.Client, templateID int64, planContent, stateContent string) ([]models.UpdateAttribute, []string, error) {
+	oldSCP, err := utils.ParseSCP(stateContent)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read the current content of the template: %w", err)
+	}
+	newSCP, err := utils.ParseSCP(planContent)
+	if err != nil {
+		return nil, nil, err
+	}
+	diff := utils.DiffSCP(oldSCP, newSCP)
+	if diff.IsStructural() {
+		return nil, nil, fmt.Errorf("attributes added to or removed from the content can not be applied to the template, added: %v, removed: %v", diff.Added, diff.Removed)
+	}
+	changes, unmatched, err := omeClient.GetTemplateContentUpdates(templateID, diff.Changed)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to apply the content to the template: %w", err)
+	}
+	tflog.Debug(ctx, "resource_template update: content changes", map[string]interface{}{
+		"changes":   len(changes),
+		"unmatched": len(unmatched),
+	})
+	return changes, unmatched, nil
+}
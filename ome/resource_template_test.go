@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 const (
@@ -302,6 +303,51 @@ func TestTemplateCreation_CreateImportTemplate(t *testing.T) {
 					resource.TestCheckResourceAttr("ome_template.citctest", "device_type", "Server"),
 				),
 			},
+			{
+				Config: testAccUpdateImportTemplateContent,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ome_template.citdtest", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_template.citdtest", "name", "test_acc_import_content_d"),
+					resource.TestCheckResourceAttr("ome_template.citdtest", "view_type", "Deployment"),
+				),
+			},
+		},
+	})
+}
+
+func TestTemplateCreation_AttributeValues(t *testing.T) {
+	if os.Getenv("TF_ACC") == "0" {
+		t.Skip("Dont run with units tests, only for Acceptance Test case")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccTemplateAttributeValues, "iDRAC,Time Zone Configuration Information,Unknown Attribute", "IST"),
+				ExpectError: regexp.MustCompile("unknown template attributes"),
+			},
+			{
+				Config: fmt.Sprintf(testAccTemplateAttributeValues, "iDRAC,Time Zone Configuration Information,Time 1 Time Zone String", "IST"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_template.attribute_values", "attribute_values.iDRAC,Time Zone Configuration Information,Time 1 Time Zone String", "IST"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccTemplateAttributeValues, "iDRAC,Time Zone Configuration Information,Time 1 Time Zone String", "UTC"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ome_template.attribute_values", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ome_template.attribute_values", "attribute_values.iDRAC,Time Zone Configuration Information,Time 1 Time Zone String", "UTC"),
+				),
+			},
 		},
 	})
 }
@@ -638,6 +684,17 @@ resource "ome_template" "terraform-acceptance-test-2" {
 	sleep_interval = 60
 }
 `
+var testAccTemplateAttributeValues = testProvider + `
+
+resource "ome_template" "attribute_values" {
+	name = "test_acc_attribute_values"
+	refdevice_servicetag = "` + DeviceSvcTag1 + `"
+	attribute_values = {
+		"%s" = "%s"
+	}
+}
+`
+
 var testAccCreateImportTemplateSuccess = testProvider + `
 
 resource "ome_template" "citdtest" {
@@ -652,6 +709,20 @@ resource "ome_template" "citctest" {
 }
 `
 
+var testAccUpdateImportTemplateContent = testProvider + `
+
+resource "ome_template" "citdtest" {
+	name = "test_acc_import_content_d"
+	content = replace(file("` + ContentFilePath + `"), "USR_4_3#Alert#RedfishEventing\">Disabled", "USR_4_3#Alert#RedfishEventing\">Enabled")
+}
+
+resource "ome_template" "citctest" {
+	name = "test_acc_import_content_c"
+	content = file("` + ContentFilePath + `")
+	view_type = "Compliance"
+}
+`
+
 var testAccCloneTemplateSuccess = testProvider + `
 
 resource "ome_template" "terraform-acceptance-test-1" {
@@ -176,6 +176,11 @@ func (u *uplinkUpdateResource) Read(ctx context.Context, req resource.ReadReques
 	fabricId := uplink.FabricID.ValueString()
 	omeUplinkData, err := omeClient.GetUplinkByName(fabricId, uplinkName)
 	if err != nil {
+		if clients.IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Unable to find uplink (%v), clearing state", uplinkName))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"error reading the uplink", err.Error(),
 		)
@@ -17,6 +17,7 @@ package ome
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"strings"
 	"terraform-provider-ome/clients"
@@ -130,6 +131,11 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	user, err := omeClient.GetUserByID(state.ID.ValueString())
 	if err != nil {
+		if clients.IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Unable to find user (%v), clearing state", state.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			clients.ErrGnrReadUser, err.Error(),
 		)
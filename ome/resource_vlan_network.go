@@ -2,7 +2,9 @@ package ome
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"strconv"
 	"terraform-provider-ome/clients"
 	"terraform-provider-ome/models"
 
@@ -13,7 +15,8 @@ import (
 )
 
 var (
-	_ resource.Resource = &vlanNetworkResource{}
+	_ resource.Resource                = &vlanNetworkResource{}
+	_ resource.ResourceWithImportState = &vlanNetworkResource{}
 )
 
 func NewVlanNetworkResource() resource.Resource {
@@ -133,6 +136,11 @@ func (r *vlanNetworkResource) Read(ctx context.Context, req resource.ReadRequest
 	defer omeClient.RemoveSession()
 	vlan, err := omeClient.GetVlanNetwork(state.VlanID.ValueInt64())
 	if err != nil {
+		if clients.IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Unable to find VLAN network (%v), clearing state", state.VlanID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			clients.ErrGnrReadVlanNetwork, err.Error(),
 		)
@@ -219,6 +227,36 @@ func (r *vlanNetworkResource) Delete(ctx context.Context, req resource.DeleteReq
 	tflog.Trace(ctx, "resource_network_vlan delete: finished "+vlan)
 }
 
+// ImportState imports a VLAN network by its ID
+func (r *vlanNetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Trace(ctx, "resource_network_vlan import: started")
+	omeClient, d := r.p.createOMESession(ctx, "resource_network_vlan ImportState")
+	resp.Diagnostics.Append(d...)
+	if d.HasError() {
+		return
+	}
+	defer omeClient.RemoveSession()
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			clients.ErrImportVlanNetwork, "VLAN network ID must be an integer: "+req.ID,
+		)
+		return
+	}
+	vlan, err := omeClient.GetVlanNetwork(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			clients.ErrImportVlanNetwork, err.Error(),
+		)
+		return
+	}
+	state := saveVlanNetworkState(vlan)
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Trace(ctx, "resource_network_vlan import: finish")
+}
+
 func getVlanNetworkPayload(ctx context.Context, plan *models.VLanNetworksTfsdk) models.CreateVlanNetwork {
 	vlan := models.CreateVlanNetwork{
 		Name:        plan.Name.ValueString(),
@@ -16,9 +16,13 @@ package ome
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"terraform-provider-ome/clients"
+	"terraform-provider-ome/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
@@ -45,19 +49,9 @@ func (v validFqddsValidator) ValidateString(ctx context.Context, req validator.S
 	}
 	inputFqdds := fqdds.ValueString()
 	multipleInputFqdds := strings.Split(inputFqdds, ",")
-	multipleValidFqdds := strings.Split(clients.ValidFQDDS, ",")
-	isValid := false
 
 	for _, inpFqdds := range multipleInputFqdds {
-		inputFqddsVal := strings.TrimSpace(inpFqdds)
-		isValid = false
-		for _, validFqdds := range multipleValidFqdds {
-			if strings.EqualFold(validFqdds, inputFqddsVal) {
-				isValid = true
-				break
-			}
-		}
-		if !isValid {
+		if !isValidFqdds(strings.TrimSpace(inpFqdds)) {
 			resp.Diagnostics.AddAttributeError(
 				req.Path,
 				clients.ErrInvalidFqdds,
@@ -68,3 +62,77 @@ func (v validFqddsValidator) ValidateString(ctx context.Context, req validator.S
 	}
 
 }
+
+// isValidFqdds returns true when the value is one of the components supported in template creation
+func isValidFqdds(value string) bool {
+	for _, validFqdds := range strings.Split(clients.ValidFQDDS, ",") {
+		if strings.EqualFold(validFqdds, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// chassisModelRegex matches the models of chassis in the SCP content
+var chassisModelRegex = regexp.MustCompile(`(?i)(MX7000|M1000e|VRTX|FX2)`)
+
+// validateTemplateContent checks the structure of the SCP content of a template,
+// its consistency with the device type and the components supported in template creation
+func validateTemplateContent(content, deviceType string, contentPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	issues, err := utils.LintSCP(content)
+	if err != nil {
+		diags.AddAttributeError(contentPath, clients.ErrInvalidTemplateContent, err.Error())
+		return diags
+	}
+	for _, issue := range issues {
+		diags.AddAttributeError(contentPath, clients.ErrInvalidTemplateContent, issue.String())
+	}
+	if diags.HasError() {
+		return diags
+	}
+	scp, err := utils.ParseSCP(content)
+	if err != nil {
+		diags.AddAttributeError(contentPath, clients.ErrInvalidTemplateContent, err.Error())
+		return diags
+	}
+
+	model := ""
+	for _, attr := range scp.Attrs {
+		if attr.Name.Local == "Model" {
+			model = attr.Value
+		}
+	}
+	isChassis := chassisModelRegex.MatchString(model)
+	serverComponents := []string{}
+	for _, component := range scp.Components {
+		if strings.HasPrefix(component.FQDD, "MM.") || strings.HasPrefix(component.FQDD, "System.Chassis") {
+			isChassis = true
+		}
+		if strings.HasPrefix(component.FQDD, "BIOS.") || strings.HasPrefix(component.FQDD, "iDRAC.") {
+			serverComponents = append(serverComponents, component.FQDD)
+		}
+	}
+	if strings.EqualFold(deviceType, "Chassis") && len(serverComponents) > 0 {
+		diags.AddAttributeError(contentPath, clients.ErrInvalidTemplateContent,
+			fmt.Sprintf("content holds the server components %s while `device_type` is Chassis", strings.Join(serverComponents, ", ")))
+		return diags
+	}
+	if strings.EqualFold(deviceType, "Chassis") {
+		return diags
+	}
+	if isChassis && len(serverComponents) == 0 {
+		diags.AddAttributeError(contentPath, clients.ErrInvalidTemplateContent,
+			fmt.Sprintf("content is a chassis profile (model %q) while `device_type` is Server", model))
+		return diags
+	}
+	for _, component := range scp.Components {
+		category := strings.SplitN(component.FQDD, ".", 2)[0]
+		if category == "All" || !(isValidFqdds(category) || isValidFqdds(category+"Controller")) {
+			diags.AddAttributeWarning(contentPath, "Unsupported template component",
+				fmt.Sprintf("component %s is not one of the components supported in template creation (%s) and may be ignored by OME",
+					component.FQDD, clients.ValidFQDDS))
+		}
+	}
+	return diags
+}
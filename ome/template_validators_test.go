@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ome
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"terraform-provider-ome/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTemplateContent(t *testing.T) {
+	content, err := os.ReadFile(ContentFilePath)
+	assert.Nil(t, err)
+
+	diags := validateTemplateContent(string(content), "Server", path.Root("content"))
+	assert.False(t, diags.HasError(), diags)
+	assert.Empty(t, diags.Warnings())
+
+	diags = validateTemplateContent(`<SystemConfiguration Model="PowerEdge R650">
+<Component FQDD="BIOS.Setup.1-1"><Attribute Name="BootMode">Uefi</Attribute></Component>
+</SystemConfiguration>`, "Chassis", path.Root("content"))
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "BIOS.Setup.1-1")
+
+	diags = validateTemplateContent(strings.Replace(string(content), "</Component>", "", 1), "Server", path.Root("content"))
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "XML syntax error on line")
+
+	diags = validateTemplateContent(`<SystemConfiguration Model="PowerEdge MX7000">
+<Component FQDD="MM.Embedded.1"><Attribute Name="SNMP.1#AgentEnable">Enabled</Attribute></Component>
+</SystemConfiguration>`, "Server", path.Root("content"))
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "chassis profile")
+
+	diags = validateTemplateContent(`<SystemConfiguration Model="PowerEdge R650">
+<Component FQDD="BIOS.Setup.1-1"><Attribute Name="BootMode">Uefi</Attribute></Component>
+<Component FQDD="FC.Slot.2-1"><Attribute Name="PortSpeed">Auto</Attribute></Component>
+</SystemConfiguration>`, "Server", path.Root("content"))
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags.Warnings(), 1)
+}
+
+func TestIsTemplateContentUpdated(t *testing.T) {
+	content := `<SystemConfiguration Model="PowerEdge R650">
+<Component FQDD="BIOS.Setup.1-1"><Attribute Name="BootMode">Uefi</Attribute><Attribute Name="SysProfile">PerfOptimized</Attribute></Component>
+</SystemConfiguration>`
+	reordered := `<SystemConfiguration Model="PowerEdge R650">
+  <Component FQDD="BIOS.Setup.1-1">
+    <Attribute Name="SysProfile">PerfOptimized</Attribute>
+    <Attribute Name="BootMode">Uefi</Attribute>
+  </Component>
+</SystemConfiguration>`
+	state := models.SCPContent{StringValue: types.StringValue(content)}
+
+	assert.False(t, isTemplateContentUpdated(models.SCPContent{StringValue: types.StringValue(reordered)}, state))
+	assert.True(t, isTemplateContentUpdated(models.SCPContent{StringValue: types.StringValue(strings.Replace(reordered, "Uefi", "Bios", 1))}, state))
+	assert.False(t, isTemplateContentUpdated(models.SCPContent{StringValue: types.StringUnknown()}, state))
+}
+
+func TestRefreshAttributeValues(t *testing.T) {
+	attributeValues := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"BIOS,BIOS Boot Settings,Boot Mode": types.StringValue("Bios"),
+		"NIC,Port Settings,Link Speed":      types.StringValue("10Gbps"),
+	})
+	omeAttributes := []models.OmeAttribute{
+		{AttributeID: 110, DisplayName: "BIOS,BIOS Boot Settings,Boot Mode", Value: "Uefi"},
+		{AttributeID: 130, DisplayName: "NIC,Port Settings,Link Speed", Value: "Auto"},
+		{AttributeID: 131, DisplayName: "NIC,Port Settings,Link Speed", Value: "10Gbps"},
+	}
+	refreshed, warnings := refreshAttributeValues(attributeValues, omeAttributes)
+	// the ambiguous path keeps its value and is reported as a warning
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"BIOS,BIOS Boot Settings,Boot Mode": types.StringValue("Uefi"),
+		"NIC,Port Settings,Link Speed":      types.StringValue("10Gbps"),
+	}), refreshed)
+	assert.Equal(t, []string{"NIC,Port Settings,Link Speed matches several attributes of the template: 130 (Auto), 131 (10Gbps), the value of the path is not refreshed"}, warnings)
+
+	refreshed, warnings = refreshAttributeValues(types.MapNull(types.StringType), omeAttributes)
+	assert.True(t, refreshed.IsNull())
+	assert.Empty(t, warnings)
+}
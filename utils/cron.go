@@ -0,0 +1,346 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronField describes one of the seven fields of an OME cron expression
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+// CronFields - fields of an OME cron expression, in order
+var CronFields = []string{"second", "minute", "hour", "day_of_month", "month", "day_of_week", "year"}
+
+var cronFieldSpecs = map[string]cronField{
+	"second":       {name: "second", min: 0, max: 59},
+	"minute":       {name: "minute", min: 0, max: 59},
+	"hour":         {name: "hour", min: 0, max: 23},
+	"day_of_month": {name: "day_of_month", min: 1, max: 31},
+	"month": {name: "month", min: 1, max: 12,
+		names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	"day_of_week": {name: "day_of_week", min: 1, max: 7,
+		names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+	"year": {name: "year", min: 1970, max: 2099},
+}
+
+var (
+	cronLastDayRegex     = regexp.MustCompile(`^L(W|-\d+)?$`)
+	cronWeekdayRegex     = regexp.MustCompile(`^(\d+)W$`)
+	cronLastWeekdayRegex = regexp.MustCompile(`^(\w+)L$`)
+	cronNthWeekdayRegex  = regexp.MustCompile(`^(\w+)#([1-5])$`)
+)
+
+// BuildCron builds the 7-field cron expression used by OME from its fields.
+// Unset time fields finer than the coarsest time field set default to 0, the other unset fields default to *.
+// Exactly one of day_of_month and day_of_week is ? in the resulting expression, the other one defaults to * when it is unset.
+func BuildCron(fields map[string]string) (string, error) {
+	for key := range fields {
+		if _, ok := cronFieldSpecs[key]; !ok {
+			return "", fmt.Errorf("unsupported cron field %s, supported fields are %s", key, strings.Join(CronFields, ", "))
+		}
+	}
+
+	values := map[string]string{}
+	for key, value := range fields {
+		if value = strings.ToUpper(strings.TrimSpace(value)); value != "" {
+			values[key] = value
+		}
+	}
+
+	timeFields := []string{"hour", "minute", "second"}
+	coarsest := len(timeFields)
+	for i, key := range timeFields {
+		if _, ok := values[key]; ok {
+			coarsest = i
+			break
+		}
+	}
+	for i, key := range timeFields {
+		if _, ok := values[key]; ok {
+			continue
+		}
+		if i > coarsest || coarsest == len(timeFields) {
+			values[key] = "0"
+		} else {
+			values[key] = "*"
+		}
+	}
+
+	dayOfMonth, dayOfMonthSet := values["day_of_month"]
+	dayOfWeek, dayOfWeekSet := values["day_of_week"]
+	switch {
+	case !dayOfMonthSet && !dayOfWeekSet:
+		values["day_of_month"], values["day_of_week"] = "*", "?"
+	case !dayOfMonthSet && dayOfWeek == "?":
+		values["day_of_month"] = "*"
+	case !dayOfMonthSet:
+		values["day_of_month"] = "?"
+	case !dayOfWeekSet && dayOfMonth == "?":
+		values["day_of_week"] = "*"
+	case !dayOfWeekSet:
+		values["day_of_week"] = "?"
+	case dayOfMonth != "?" && dayOfWeek != "?":
+		return "", errors.New("day_of_month and day_of_week cannot both be set, one of them must be ?")
+	case dayOfMonth == "?" && dayOfWeek == "?":
+		return "", errors.New("day_of_month and day_of_week cannot both be ?")
+	}
+	for _, key := range []string{"month", "year"} {
+		if _, ok := values[key]; !ok {
+			values[key] = "*"
+		}
+	}
+
+	expression := make([]string, 0, len(CronFields))
+	var errs []error
+	for _, key := range CronFields {
+		if err := validateCronField(cronFieldSpecs[key], values[key]); err != nil {
+			errs = append(errs, err)
+		}
+		expression = append(expression, values[key])
+	}
+	if err := errors.Join(errs...); err != nil {
+		return "", err
+	}
+	return strings.Join(expression, " "), nil
+}
+
+// ValidateCron validates a 7-field OME cron expression
+func ValidateCron(expression string) error {
+	values := strings.Fields(expression)
+	if len(values) != len(CronFields) {
+		return fmt.Errorf("cron expression %q must have %d fields, found %d", expression, len(CronFields), len(values))
+	}
+	fields := map[string]string{}
+	for i, key := range CronFields {
+		fields[key] = values[i]
+	}
+	_, err := BuildCron(fields)
+	return err
+}
+
+func validateCronField(spec cronField, value string) error {
+	if value == "?" {
+		if spec.name == "day_of_month" || spec.name == "day_of_week" {
+			return nil
+		}
+		return fmt.Errorf("%s does not support ?", spec.name)
+	}
+	for _, item := range strings.Split(value, ",") {
+		if err := validateCronItem(spec, item); err != nil {
+			return fmt.Errorf("invalid %s %q: %w", spec.name, value, err)
+		}
+	}
+	return nil
+}
+
+func validateCronItem(spec cronField, item string) error {
+	if item == "" {
+		return errors.New("empty value")
+	}
+	switch spec.name {
+	case "day_of_month":
+		if cronLastDayRegex.MatchString(item) {
+			return nil
+		}
+		if m := cronWeekdayRegex.FindStringSubmatch(item); m != nil {
+			_, err := spec.parse(m[1])
+			return err
+		}
+	case "day_of_week":
+		if item == "L" {
+			return nil
+		}
+		if m := cronLastWeekdayRegex.FindStringSubmatch(item); m != nil {
+			_, err := spec.parse(m[1])
+			return err
+		}
+		if m := cronNthWeekdayRegex.FindStringSubmatch(item); m != nil {
+			_, err := spec.parse(m[1])
+			return err
+		}
+	}
+
+	rangeExpr, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		stepValue, err := strconv.Atoi(step)
+		if err != nil || stepValue < 1 {
+			return fmt.Errorf("invalid increment %s", step)
+		}
+	}
+	if rangeExpr == "*" {
+		return nil
+	}
+	start, stop, isRange := strings.Cut(rangeExpr, "-")
+	startValue, err := spec.parse(start)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+	stopValue, err := spec.parse(stop)
+	if err != nil {
+		return err
+	}
+	if startValue > stopValue && spec.names == nil {
+		return fmt.Errorf("range %s is decreasing", rangeExpr)
+	}
+	return nil
+}
+
+// parse converts a single value of the field to its number, accepting the field names
+func (spec cronField) parse(value string) (int, error) {
+	for i, name := range spec.names {
+		if value == name {
+			return spec.min + i, nil
+		}
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		if spec.names != nil {
+			return 0, fmt.Errorf("%s is neither a number nor one of %s", value, strings.Join(spec.names, ", "))
+		}
+		return 0, fmt.Errorf("%s is not a number", value)
+	}
+	if number < spec.min || number > spec.max {
+		return 0, fmt.Errorf("%d is out of range %d-%d", number, spec.min, spec.max)
+	}
+	return number, nil
+}
+
+// CronMatches reports whether the time matches the 7-field OME cron expression.
+// A time window can be expressed by the times matching a cron expression, for example "* * 1-4 ? * SAT,SUN *".
+func CronMatches(expression string, t time.Time) (bool, error) {
+	if err := ValidateCron(expression); err != nil {
+		return false, err
+	}
+	values := strings.Fields(strings.ToUpper(expression))
+	current := map[string]int{
+		"second":       t.Second(),
+		"minute":       t.Minute(),
+		"hour":         t.Hour(),
+		"day_of_month": t.Day(),
+		"month":        int(t.Month()),
+		"day_of_week":  int(t.Weekday()) + 1,
+		"year":         t.Year(),
+	}
+	for i, key := range CronFields {
+		if !cronFieldMatches(cronFieldSpecs[key], values[i], current[key], t) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func cronFieldMatches(spec cronField, value string, current int, t time.Time) bool {
+	if value == "?" {
+		return true
+	}
+	for _, item := range strings.Split(value, ",") {
+		if cronItemMatches(spec, item, current, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// cronItemMatches reports whether the current value of the field matches an item of a validated expression
+func cronItemMatches(spec cronField, item string, current int, t time.Time) bool {
+	lastDay := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	switch spec.name {
+	case "day_of_month":
+		if m := cronLastDayRegex.FindStringSubmatch(item); m != nil {
+			switch m[1] {
+			case "":
+				return current == lastDay
+			case "W":
+				return current == nearestWeekday(t, lastDay, lastDay)
+			default:
+				offset, _ := strconv.Atoi(m[1][1:])
+				return current == lastDay-offset
+			}
+		}
+		if m := cronWeekdayRegex.FindStringSubmatch(item); m != nil {
+			day, _ := spec.parse(m[1])
+			return day <= lastDay && current == nearestWeekday(t, day, lastDay)
+		}
+	case "day_of_week":
+		if item == "L" {
+			return current == spec.max
+		}
+		if m := cronLastWeekdayRegex.FindStringSubmatch(item); m != nil {
+			weekday, _ := spec.parse(m[1])
+			return current == weekday && t.Day()+7 > lastDay
+		}
+		if m := cronNthWeekdayRegex.FindStringSubmatch(item); m != nil {
+			weekday, _ := spec.parse(m[1])
+			nth, _ := strconv.Atoi(m[2])
+			return current == weekday && (t.Day()-1)/7+1 == nth
+		}
+	}
+
+	rangeExpr, step, hasStep := strings.Cut(item, "/")
+	stepValue := 1
+	if hasStep {
+		stepValue, _ = strconv.Atoi(step)
+	}
+	start, stop := spec.min, spec.max
+	if rangeExpr != "*" {
+		startExpr, stopExpr, isRange := strings.Cut(rangeExpr, "-")
+		start, _ = spec.parse(startExpr)
+		stop = start
+		if isRange {
+			stop, _ = spec.parse(stopExpr)
+		} else if hasStep {
+			stop = spec.max
+		}
+	}
+	offset := current - start
+	// ranges of names can wrap around, for example FRI-MON
+	if start > stop {
+		if offset < 0 {
+			offset += spec.max - spec.min + 1
+		}
+		stop += spec.max - spec.min + 1
+	}
+	return offset >= 0 && start+offset <= stop && offset%stepValue == 0
+}
+
+// nearestWeekday returns the weekday of the month of t nearest to the given day, without leaving the month
+func nearestWeekday(t time.Time, day, lastDay int) int {
+	switch time.Date(t.Year(), t.Month(), day, 0, 0, 0, 0, t.Location()).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == lastDay {
+			return day - 2
+		}
+		return day + 1
+	}
+	return day
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildCron(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   map[string]string
+		expected string
+	}{
+		{"Empty", map[string]string{}, "0 0 0 * * ? *"},
+		{"Hour", map[string]string{"hour": "2"}, "0 0 2 * * ? *"},
+		{"Minute", map[string]string{"minute": "*/15"}, "0 */15 * * * ? *"},
+		{"SecondAndHour", map[string]string{"second": "30", "hour": "4"}, "30 0 4 * * ? *"},
+		{"DayOfWeek", map[string]string{"hour": "23", "minute": "30", "day_of_week": "mon-fri"}, "0 30 23 ? * MON-FRI *"},
+		{"DayOfMonth", map[string]string{"hour": "1", "day_of_month": "L", "month": "1,4,7,10"}, "0 0 1 L 1,4,7,10 ? *"},
+		{"NthWeekday", map[string]string{"day_of_week": "SAT#2", "year": "2026"}, "0 0 0 ? * SAT#2 2026"},
+		{"ExplicitQuestionMark", map[string]string{"day_of_month": "15W", "day_of_week": "?"}, "0 0 0 15W * ? *"},
+		{"OnlyDayOfMonthQuestionMark", map[string]string{"day_of_month": "?"}, "0 0 0 ? * * *"},
+		{"OnlyDayOfWeekQuestionMark", map[string]string{"day_of_week": "?"}, "0 0 0 * * ? *"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := BuildCron(tt.fields)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, cron)
+			assert.Nil(t, ValidateCron(cron))
+		})
+	}
+}
+
+func TestBuildCronErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields map[string]string
+	}{
+		{"UnknownField", map[string]string{"minutes": "5"}},
+		{"BothDays", map[string]string{"day_of_month": "1", "day_of_week": "MON"}},
+		{"NoDays", map[string]string{"day_of_month": "?", "day_of_week": "?"}},
+		{"HourOutOfRange", map[string]string{"hour": "24"}},
+		{"DecreasingRange", map[string]string{"minute": "30-10"}},
+		{"InvalidStep", map[string]string{"minute": "*/0"}},
+		{"InvalidMonth", map[string]string{"month": "JANUARY"}},
+		{"QuestionMarkHour", map[string]string{"hour": "?"}},
+		{"YearOutOfRange", map[string]string{"year": "2100"}},
+		{"EmptyItem", map[string]string{"minute": "1,,2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := BuildCron(tt.fields)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestValidateCron(t *testing.T) {
+	assert.Nil(t, ValidateCron("0 * */10 * * ? *"))
+	assert.NotNil(t, ValidateCron("0 * */10 * * ?"))
+	assert.NotNil(t, ValidateCron("abc"))
+}
+
+func TestCronMatches(t *testing.T) {
+	// 2026-10-17 is a Saturday
+	saturday := time.Date(2026, time.October, 17, 2, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		expression string
+		time       time.Time
+		expected   bool
+	}{
+		{"Any", "* * * * * ? *", saturday, true},
+		{"WeekendNights", "* * 1-4 ? * SAT,SUN *", saturday, true},
+		{"WeekendNightsOutside", "* * 1-4 ? * SAT,SUN *", saturday.Add(3 * time.Hour), false},
+		{"WeekdaysOnSaturday", "* * * ? * MON-FRI *", saturday, false},
+		{"WrappingRange", "* * * ? * FRI-MON *", saturday, true},
+		{"WrappingRangeOutside", "* * * ? * FRI-MON *", saturday.AddDate(0, 0, 3), false},
+		{"Step", "* */15 * * * ? *", saturday, true},
+		{"StepOutside", "* */20 * * * ? *", saturday, false},
+		{"StartStep", "* 10/20 * * * ? *", saturday, true},
+		{"LastDayOfMonth", "* * * L * ? *", time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC), true},
+		{"LastDayOffset", "* * * L-2 * ? *", time.Date(2026, time.October, 29, 0, 0, 0, 0, time.UTC), true},
+		{"NearestWeekday", "* * * 17W * ? *", time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC), true},
+		{"NearestWeekdayOnSaturday", "* * * 17W * ? *", saturday, false},
+		{"LastWeekdayOfMonth", "* * * LW * ? *", time.Date(2026, time.October, 30, 0, 0, 0, 0, time.UTC), true},
+		{"ThirdSaturday", "* * * ? * SAT#3 *", saturday, true},
+		{"SecondSaturday", "* * * ? * SAT#2 *", saturday, false},
+		{"LastSaturday", "* * * ? * 7L *", time.Date(2026, time.October, 31, 0, 0, 0, 0, time.UTC), true},
+		{"NotLastSaturday", "* * * ? * 7L *", saturday, false},
+		{"Year", "* * * * * ? 2027", saturday, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := CronMatches(tt.expression, tt.time)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, matches)
+		})
+	}
+	_, err := CronMatches("* * *", saturday)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// fqddRegex matches a Fully Qualified Device Descriptor such as NIC.Integrated.1-1-1 or Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1
+var fqddRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*\.[A-Za-z0-9]+(\.[A-Za-z0-9]+)*(-\d+)*(:[A-Za-z][A-Za-z0-9]*\.[A-Za-z0-9.]+(-\d+)*)*$`)
+
+// AttributeDisplayName - parts of the display name of a template attribute
+type AttributeDisplayName struct {
+	// Component is the top level attribute group, for example NIC or iDRAC
+	Component string
+	// FQDD is the first group of the hierarchy which is a device descriptor, empty when there is none
+	FQDD string
+	// Groups are the attribute groups between the component and the attribute
+	Groups []string
+	// Attribute is the display name of the attribute itself
+	Attribute string
+}
+
+// ParseAttributeDisplayName splits the comma separated display name of a template attribute,
+// for example "NIC,NIC.Integrated.1-1-1,iSCSI General Parameters,Boot to Target"
+func ParseAttributeDisplayName(displayName string) (AttributeDisplayName, error) {
+	var parsed AttributeDisplayName
+	parts := strings.Split(displayName, ",")
+	if len(parts) < 2 {
+		return parsed, fmt.Errorf("%q is not a template attribute display name, expected at least a component and an attribute separated by a comma", displayName)
+	}
+	for i, part := range parts {
+		if parts[i] = strings.TrimSpace(part); parts[i] == "" {
+			return parsed, fmt.Errorf("%q has an empty element at position %d", displayName, i+1)
+		}
+	}
+	parsed.Component = parts[0]
+	parsed.Attribute = parts[len(parts)-1]
+	parsed.Groups = parts[1 : len(parts)-1]
+	for _, group := range parsed.Groups {
+		if IsFQDD(group) {
+			parsed.FQDD = group
+			break
+		}
+	}
+	return parsed, nil
+}
+
+// IsFQDD checks if the value is a Fully Qualified Device Descriptor
+func IsFQDD(value string) bool {
+	return fqddRegex.MatchString(value)
+}
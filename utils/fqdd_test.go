@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAttributeDisplayName(t *testing.T) {
+	parsed, err := ParseAttributeDisplayName("NIC,NIC.Integrated.1-1-1,iSCSI General Parameters,Boot to Target")
+	assert.Nil(t, err)
+	assert.Equal(t, AttributeDisplayName{
+		Component: "NIC",
+		FQDD:      "NIC.Integrated.1-1-1",
+		Groups:    []string{"NIC.Integrated.1-1-1", "iSCSI General Parameters"},
+		Attribute: "Boot to Target",
+	}, parsed)
+
+	parsed, err = ParseAttributeDisplayName("iDRAC,Users,User 2,User Name")
+	assert.Nil(t, err)
+	assert.Equal(t, "iDRAC", parsed.Component)
+	assert.Empty(t, parsed.FQDD)
+	assert.Equal(t, []string{"Users", "User 2"}, parsed.Groups)
+	assert.Equal(t, "User Name", parsed.Attribute)
+
+	parsed, err = ParseAttributeDisplayName("ServerTopology,ServerTopology 1 Aisle Name")
+	assert.Nil(t, err)
+	assert.Empty(t, parsed.Groups)
+
+	_, err = ParseAttributeDisplayName("BIOS")
+	assert.NotNil(t, err)
+	_, err = ParseAttributeDisplayName("BIOS,,Boot Mode")
+	assert.NotNil(t, err)
+}
+
+func TestIsFQDD(t *testing.T) {
+	for _, v := range []string{"NIC.Integrated.1-1-1", "iDRAC.Embedded.1", "RAID.Integrated.1-1", "Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1"} {
+		assert.Truef(t, IsFQDD(v), "%s is an FQDD", v)
+	}
+	for _, v := range []string{"iSCSI General Parameters", "User 2", "BIOS", ""} {
+		assert.Falsef(t, IsFQDD(v), "%s is not an FQDD", v)
+	}
+}
@@ -37,6 +37,16 @@ func ContainsString(ctx context.Context, strList []string, stringToCheck string)
 	return false
 }
 
+// ContainsFold checks if the string is in the slice, ignoring the case
+func ContainsFold(strList []string, stringToCheck string) bool {
+	for _, value := range strList {
+		if strings.EqualFold(value, stringToCheck) {
+			return true
+		}
+	}
+	return false
+}
+
 // CopyFields copy the source of a struct to destination of struct with terraform types.
 func CopyFields(ctx context.Context, source, destination interface{}) error {
 	tflog.Debug(ctx, "Copy fields", map[string]interface{}{
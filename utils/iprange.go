@@ -100,3 +100,38 @@ func (is IPSet) Contains(ip net.IP) bool {
 	}
 	return false
 }
+
+// Addresses lists the IPs of the range, failing when the range holds more than limit IPs
+func (ir IPRange) Addresses(limit int) ([]string, error) {
+	start, contains := ir.start, func(addr netip.Addr) bool { return addr.Compare(ir.stop) <= 0 }
+	if ir.cidr != nil {
+		prefix, err := netip.ParsePrefix(ir.cidr.String())
+		if err != nil {
+			return nil, err
+		}
+		start, contains = prefix.Masked().Addr(), prefix.Contains
+	} else if ir.start.Compare(ir.stop) > 0 {
+		return nil, fmt.Errorf("first address %s is greater than last address %s", ir.start, ir.stop)
+	}
+	addresses := make([]string, 0)
+	for addr := start; addr.IsValid() && contains(addr); addr = addr.Next() {
+		if len(addresses) == limit {
+			return nil, fmt.Errorf("range holds more than %d addresses", limit)
+		}
+		addresses = append(addresses, addr.String())
+	}
+	return addresses, nil
+}
+
+// ExpandNetwork lists the IPs of a single IP, an IP range or a CIDR, failing when it holds more than limit IPs
+func ExpandNetwork(network string, limit int) ([]string, error) {
+	ipr, err := ParseNetwork(network)
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := ipr.Addresses(limit)
+	if err != nil {
+		return nil, fmt.Errorf("could not expand %s: %w", network, err)
+	}
+	return addresses, nil
+}
@@ -125,3 +125,25 @@ func TestIPRangeNeg(t *testing.T) {
 		assert.Falsef(t, ipr.Contains(ip), "%s found in %s", ips, v)
 	}
 }
+
+func TestExpandNetwork(t *testing.T) {
+	TCs := map[string][]string{
+		"192.35.0.1":                      {"192.35.0.1"},
+		" 10.36.0.254 - 10.36.1.1 ":       {"10.36.0.254", "10.36.0.255", "10.36.1.0", "10.36.1.1"},
+		"192.37.0.0/30":                   {"192.37.0.0", "192.37.0.1", "192.37.0.2", "192.37.0.3"},
+		"192.37.0.5/31":                   {"192.37.0.4", "192.37.0.5"},
+		"fe80::ffff:192.0.2.0/127":        {"fe80::ffff:c000:200", "fe80::ffff:c000:201"},
+		"fe80::fffe - fe80::1:0":          {"fe80::fffe", "fe80::ffff", "fe80::1:0"},
+		"255.255.255.254-255.255.255.255": {"255.255.255.254", "255.255.255.255"},
+	}
+	for v, expected := range TCs {
+		addresses, err := ExpandNetwork(v, 16)
+		assert.Nilf(t, err, "No error expected for %s", v)
+		assert.Equalf(t, expected, addresses, "Unexpected expansion of %s", v)
+	}
+
+	for _, v := range []string{"hallo", "10.36.0.20-10.36.0.10", "192.37.0.0/24", "10.36.0.0-fe80::1"} {
+		_, err := ExpandNetwork(v, 16)
+		assert.NotNilf(t, err, "Expected error, but none found for %s", v)
+	}
+}
@@ -0,0 +1,374 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// SCPRedactedValue - value replacing the secrets of a redacted SCP
+	SCPRedactedValue = "******"
+	// scpAttributeKeySeparator - separator between the FQDD and the name of an attribute in attribute keys
+	scpAttributeKeySeparator = "/"
+	// scpTimeStampAttr - attribute of the SCP root element holding the export time
+	scpTimeStampAttr = "TimeStamp"
+)
+
+// scpSecretRegex matches the names of the SCP attributes holding secrets
+var scpSecretRegex = regexp.MustCompile(`(?i)(password|passwd|passphrase|secret|community|encryptionkey|privatekey|psk)`)
+
+// SCP - Server Configuration Profile, the XML content of OME templates
+type SCP struct {
+	XMLName    xml.Name       `xml:"SystemConfiguration"`
+	Attrs      []xml.Attr     `xml:",any,attr"`
+	Components []SCPComponent `xml:"Component"`
+}
+
+// SCPComponent - component of a SCP, identified by its FQDD
+type SCPComponent struct {
+	FQDD       string         `xml:"FQDD,attr"`
+	Attributes []SCPAttribute `xml:"Attribute"`
+	Components []SCPComponent `xml:"Component"`
+}
+
+// SCPAttribute - attribute of a SCP component
+type SCPAttribute struct {
+	Name  string `xml:"Name,attr"`
+	Value string `xml:",chardata"`
+}
+
+// ParseSCP parses the XML content of a template
+func ParseSCP(content string) (SCP, error) {
+	var scp SCP
+	if err := xml.Unmarshal([]byte(content), &scp); err != nil {
+		return scp, fmt.Errorf("invalid SCP content: %w", err)
+	}
+	return scp, nil
+}
+
+// String returns the indented XML content of the SCP
+func (s SCP) String() string {
+	content, _ := xml.MarshalIndent(s, "", "  ")
+	return string(content)
+}
+
+// FilterFQDDs keeps the components whose FQDD starts with one of the prefixes, along with their sub components.
+// Components which don't match are kept without their attributes when one of their sub components matches.
+func (s *SCP) FilterFQDDs(prefixes []string) {
+	s.Components = filterSCPComponents(s.Components, prefixes)
+}
+
+func filterSCPComponents(components []SCPComponent, prefixes []string) []SCPComponent {
+	filtered := make([]SCPComponent, 0)
+	for _, component := range components {
+		if hasAnyPrefix(component.FQDD, prefixes) {
+			filtered = append(filtered, component)
+			continue
+		}
+		if subComponents := filterSCPComponents(component.Components, prefixes); len(subComponents) > 0 {
+			filtered = append(filtered, SCPComponent{
+				FQDD:       component.FQDD,
+				Components: subComponents,
+			})
+		}
+	}
+	return filtered
+}
+
+func hasAnyPrefix(value string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact replaces the values of the attributes holding secrets and returns the number of redacted attributes
+func (s *SCP) Redact() int {
+	return redactSCPComponents(s.Components)
+}
+
+func redactSCPComponents(components []SCPComponent) int {
+	count := 0
+	for i := range components {
+		for j, attribute := range components[i].Attributes {
+			if scpSecretRegex.MatchString(attribute.Name) && attribute.Value != "" {
+				components[i].Attributes[j].Value = SCPRedactedValue
+				count++
+			}
+		}
+		count += redactSCPComponents(components[i].Components)
+	}
+	return count
+}
+
+// AttributeMap returns the values of the attributes of the SCP keyed by FQDD/attribute name
+func (s SCP) AttributeMap() map[string]string {
+	attributes := map[string]string{}
+	addSCPAttributes(s.Components, attributes)
+	return attributes
+}
+
+func addSCPAttributes(components []SCPComponent, attributes map[string]string) {
+	for _, component := range components {
+		for _, attribute := range component.Attributes {
+			attributes[SCPAttributeKey(component.FQDD, attribute.Name)] = strings.TrimSpace(attribute.Value)
+		}
+		addSCPAttributes(component.Components, attributes)
+	}
+}
+
+// SCPAttributeKey returns the key of an attribute in the attribute map of a SCP
+func SCPAttributeKey(fqdd, name string) string {
+	return fqdd + scpAttributeKeySeparator + name
+}
+
+// SplitSCPAttributeKey returns the FQDD of the component and the name of the attribute of a key of the attribute map of a SCP
+func SplitSCPAttributeKey(key string) (string, string) {
+	fqdd, name, _ := strings.Cut(key, scpAttributeKeySeparator)
+	return fqdd, name
+}
+
+// SCPAttributeChange - value change of a SCP attribute
+type SCPAttributeChange struct {
+	Key      string
+	OldValue string
+	NewValue string
+}
+
+// SCPDiff - attribute level differences between two SCP, attributes are identified by their keys
+type SCPDiff struct {
+	Added   []string
+	Removed []string
+	Changed []SCPAttributeChange
+}
+
+// DiffSCP returns the attribute level differences from the old SCP to the new one, sorted by attribute key
+func DiffSCP(oldSCP, newSCP SCP) SCPDiff {
+	oldAttributes := oldSCP.AttributeMap()
+	newAttributes := newSCP.AttributeMap()
+	diff := SCPDiff{
+		Added:   make([]string, 0),
+		Removed: make([]string, 0),
+		Changed: make([]SCPAttributeChange, 0),
+	}
+	for key, newValue := range newAttributes {
+		oldValue, ok := oldAttributes[key]
+		if !ok {
+			diff.Added = append(diff.Added, key)
+		} else if oldValue != newValue {
+			diff.Changed = append(diff.Changed, SCPAttributeChange{
+				Key:      key,
+				OldValue: oldValue,
+				NewValue: newValue,
+			})
+		}
+	}
+	for key := range oldAttributes {
+		if _, ok := newAttributes[key]; !ok {
+			diff.Removed = append(diff.Removed, key)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Slice(diff.Changed, func(i, j int) bool {
+		return diff.Changed[i].Key < diff.Changed[j].Key
+	})
+	return diff
+}
+
+// IsEmpty returns true when both SCP hold the same attributes with the same values
+func (d SCPDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// IsStructural returns true when attributes are added or removed,
+// such changes can't be applied on the attributes of an existing template
+func (d SCPDiff) IsStructural() bool {
+	return len(d.Added) != 0 || len(d.Removed) != 0
+}
+
+// Normalize sorts the components by FQDD and the attributes by name, trims the attribute values
+// and drops the export timestamp, so that cosmetic changes don't alter the SCP
+func (s *SCP) Normalize() {
+	attrs := make([]xml.Attr, 0, len(s.Attrs))
+	for _, attr := range s.Attrs {
+		if attr.Name.Local != scpTimeStampAttr {
+			attrs = append(attrs, attr)
+		}
+	}
+	sort.SliceStable(attrs, func(i, j int) bool {
+		return attrs[i].Name.Local < attrs[j].Name.Local
+	})
+	s.Attrs = attrs
+	normalizeSCPComponents(s.Components)
+}
+
+func normalizeSCPComponents(components []SCPComponent) {
+	sort.SliceStable(components, func(i, j int) bool {
+		return components[i].FQDD < components[j].FQDD
+	})
+	for i := range components {
+		for j := range components[i].Attributes {
+			components[i].Attributes[j].Value = strings.TrimSpace(components[i].Attributes[j].Value)
+		}
+		sort.SliceStable(components[i].Attributes, func(j, k int) bool {
+			return components[i].Attributes[j].Name < components[i].Attributes[k].Name
+		})
+		normalizeSCPComponents(components[i].Components)
+	}
+}
+
+// EquivalentSCP returns true when both contents are the same SCP once normalized.
+// Contents which are not valid SCP are compared as is.
+func EquivalentSCP(content1, content2 string) bool {
+	if content1 == content2 {
+		return true
+	}
+	scp1, err1 := ParseSCP(content1)
+	scp2, err2 := ParseSCP(content2)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	scp1.Normalize()
+	scp2.Normalize()
+	return scp1.String() == scp2.String()
+}
+
+// SCPIssue - problem found in the content of a SCP, located by its line
+type SCPIssue struct {
+	Line      int
+	FQDD      string
+	Attribute string
+	Message   string
+}
+
+func (i SCPIssue) String() string {
+	location := fmt.Sprintf("line %d", i.Line)
+	if i.FQDD != "" {
+		location += fmt.Sprintf(", component %s", i.FQDD)
+	}
+	if i.Attribute != "" {
+		location += fmt.Sprintf(", attribute %s", i.Attribute)
+	}
+	return fmt.Sprintf("%s: %s", location, i.Message)
+}
+
+// scpLintComponent - component being read while linting a SCP
+type scpLintComponent struct {
+	fqdd       string
+	attributes map[string]int
+	components map[string]int
+}
+
+// LintSCP checks the structure of the XML content of a SCP: the root element, the FQDD of the components
+// and the duplicate components and attributes. An error is returned when the content is not well formed XML.
+func LintSCP(content string) ([]SCPIssue, error) {
+	issues := make([]SCPIssue, 0)
+	decoder := xml.NewDecoder(strings.NewReader(content))
+	stack := []*scpLintComponent{{components: map[string]int{}}}
+	depth := 0
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return issues, err
+		}
+		line, _ := decoder.InputPos()
+		switch element := token.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 1 {
+				if element.Name.Local != "SystemConfiguration" {
+					issues = append(issues, SCPIssue{Line: line, Message: fmt.Sprintf("root element is %s instead of SystemConfiguration", element.Name.Local)})
+				}
+				continue
+			}
+			parent := stack[len(stack)-1]
+			switch element.Name.Local {
+			case "Component":
+				fqdd := getXMLAttr(element, "FQDD")
+				issues = append(issues, lintSCPComponent(line, fqdd, parent)...)
+				stack = append(stack, &scpLintComponent{
+					fqdd:       fqdd,
+					attributes: map[string]int{},
+					components: map[string]int{},
+				})
+			case "Attribute":
+				issues = append(issues, lintSCPAttribute(line, getXMLAttr(element, "Name"), parent)...)
+			}
+		case xml.EndElement:
+			depth--
+			if element.Name.Local == "Component" && len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+	if len(stack[0].components) == 0 {
+		issues = append(issues, SCPIssue{Line: 1, Message: "no component is defined"})
+	}
+	return issues, nil
+}
+
+func lintSCPComponent(line int, fqdd string, parent *scpLintComponent) []SCPIssue {
+	issues := make([]SCPIssue, 0)
+	switch {
+	case fqdd == "":
+		issues = append(issues, SCPIssue{Line: line, Message: "component has no FQDD"})
+	case !IsFQDD(fqdd):
+		issues = append(issues, SCPIssue{Line: line, FQDD: fqdd, Message: "FQDD is not well formed"})
+	case parent.fqdd != "" && !strings.Contains(fqdd, parent.fqdd):
+		issues = append(issues, SCPIssue{Line: line, FQDD: fqdd, Message: fmt.Sprintf("FQDD does not belong to its parent component %s", parent.fqdd)})
+	}
+	if fqdd != "" {
+		if first, ok := parent.components[fqdd]; ok {
+			issues = append(issues, SCPIssue{Line: line, FQDD: fqdd, Message: fmt.Sprintf("duplicate component, first defined on line %d", first)})
+		} else {
+			parent.components[fqdd] = line
+		}
+	}
+	return issues
+}
+
+func lintSCPAttribute(line int, name string, component *scpLintComponent) []SCPIssue {
+	if component.attributes == nil {
+		return []SCPIssue{{Line: line, Attribute: name, Message: "attribute is not defined in a component"}}
+	}
+	if name == "" {
+		return []SCPIssue{{Line: line, FQDD: component.fqdd, Message: "attribute has no name"}}
+	}
+	if first, ok := component.attributes[name]; ok {
+		return []SCPIssue{{Line: line, FQDD: component.fqdd, Attribute: name, Message: fmt.Sprintf("duplicate attribute, first defined on line %d", first)}}
+	}
+	component.attributes[name] = line
+	return nil
+}
+
+func getXMLAttr(element xml.StartElement, name string) string {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSCP = `
+<SystemConfiguration Model="PowerEdge R650" ServiceTag="CZMC1T2" TimeStamp="Tue Oct 14 10:12:45 2025">
+  <Component FQDD="iDRAC.Embedded.1">
+    <Attribute Name="Users.2#UserName">root</Attribute>
+    <Attribute Name="Users.2#Password">calvin</Attribute>
+    <Attribute Name="SNMP.1#AgentCommunity">public</Attribute>
+    <!-- <Attribute Name="Info.1#Product">Integrated Dell Remote Access Controller</Attribute> -->
+  </Component>
+  <Component FQDD="BIOS.Setup.1-1">
+    <Attribute Name="BootMode">Uefi</Attribute>
+    <Attribute Name="SysProfile"> PerfOptimized </Attribute>
+  </Component>
+  <Component FQDD="RAID.Integrated.1-1">
+    <Attribute Name="RAIDresetConfig">False</Attribute>
+    <Component FQDD="Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1">
+      <Attribute Name="RAIDPDState">Ready</Attribute>
+    </Component>
+  </Component>
+  <Component FQDD="NIC.Integrated.1-1-1">
+    <Attribute Name="LegacyBootProto">PXE</Attribute>
+  </Component>
+</SystemConfiguration>`
+
+func TestParseSCP(t *testing.T) {
+	scp, err := ParseSCP(testSCP)
+	assert.Nil(t, err)
+	assert.Len(t, scp.Components, 4)
+	assert.Len(t, scp.Attrs, 3)
+	assert.Len(t, scp.Components[0].Attributes, 3)
+	assert.Equal(t, "Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1", scp.Components[2].Components[0].FQDD)
+
+	// the content can be parsed back
+	reparsed, err := ParseSCP(scp.String())
+	assert.Nil(t, err)
+	assert.Equal(t, scp.AttributeMap(), reparsed.AttributeMap())
+	assert.Contains(t, scp.String(), `ServiceTag="CZMC1T2"`)
+
+	_, err = ParseSCP("<SystemConfiguration><Component>")
+	assert.NotNil(t, err)
+	_, err = ParseSCP("<Configuration></Configuration>")
+	assert.NotNil(t, err)
+}
+
+func TestSCPAttributeMap(t *testing.T) {
+	scp, _ := ParseSCP(testSCP)
+	attributes := scp.AttributeMap()
+	assert.Len(t, attributes, 8)
+	assert.Equal(t, "PerfOptimized", attributes["BIOS.Setup.1-1/SysProfile"])
+	assert.Equal(t, "Ready", attributes["Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1/RAIDPDState"])
+	assert.NotContains(t, attributes, "iDRAC.Embedded.1/Info.1#Product")
+}
+
+func TestSCPRedact(t *testing.T) {
+	scp, _ := ParseSCP(testSCP)
+	assert.Equal(t, 2, scp.Redact())
+	attributes := scp.AttributeMap()
+	assert.Equal(t, SCPRedactedValue, attributes["iDRAC.Embedded.1/Users.2#Password"])
+	assert.Equal(t, SCPRedactedValue, attributes["iDRAC.Embedded.1/SNMP.1#AgentCommunity"])
+	assert.Equal(t, "root", attributes["iDRAC.Embedded.1/Users.2#UserName"])
+	assert.False(t, strings.Contains(scp.String(), "calvin"))
+}
+
+func TestSCPFilterFQDDs(t *testing.T) {
+	scp, _ := ParseSCP(testSCP)
+	scp.FilterFQDDs([]string{"BIOS", "NIC.Integrated.1"})
+	assert.Len(t, scp.Components, 2)
+	assert.Equal(t, "BIOS.Setup.1-1", scp.Components[0].FQDD)
+	assert.Equal(t, "NIC.Integrated.1-1-1", scp.Components[1].FQDD)
+
+	scp, _ = ParseSCP(testSCP)
+	scp.FilterFQDDs([]string{"Disk."})
+	assert.Len(t, scp.Components, 1)
+	assert.Empty(t, scp.Components[0].Attributes)
+	assert.Equal(t, map[string]string{
+		"Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1/RAIDPDState": "Ready",
+	}, scp.AttributeMap())
+}
+
+func TestDiffSCP(t *testing.T) {
+	oldSCP, _ := ParseSCP(testSCP)
+
+	newSCP, _ := ParseSCP(strings.Replace(strings.Replace(testSCP, "Uefi", "Bios", 1), "PXE", "NONE", 1))
+	diff := DiffSCP(oldSCP, newSCP)
+	assert.False(t, diff.IsEmpty())
+	assert.False(t, diff.IsStructural())
+	assert.Equal(t, []SCPAttributeChange{
+		{Key: "BIOS.Setup.1-1/BootMode", OldValue: "Uefi", NewValue: "Bios"},
+		{Key: "NIC.Integrated.1-1-1/LegacyBootProto", OldValue: "PXE", NewValue: "NONE"},
+	}, diff.Changed)
+
+	// comments and surrounding spaces are not differences
+	newSCP, _ = ParseSCP(strings.Replace(strings.Replace(testSCP, "<!--", "<!-- disabled", 1), " PerfOptimized ", "PerfOptimized", 1))
+	assert.True(t, DiffSCP(oldSCP, newSCP).IsEmpty())
+
+	newSCP, _ = ParseSCP(strings.Replace(testSCP, `<Attribute Name="BootMode">Uefi</Attribute>`, `<Attribute Name="BootSeqRetry">Enabled</Attribute>`, 1))
+	diff = DiffSCP(oldSCP, newSCP)
+	assert.True(t, diff.IsStructural())
+	assert.Equal(t, []string{"BIOS.Setup.1-1/BootSeqRetry"}, diff.Added)
+	assert.Equal(t, []string{"BIOS.Setup.1-1/BootMode"}, diff.Removed)
+	assert.Empty(t, diff.Changed)
+}
+
+func TestEquivalentSCP(t *testing.T) {
+	reordered := `<SystemConfiguration ServiceTag="CZMC1T2" Model="PowerEdge R650" TimeStamp="Wed Oct 15 08:00:00 2025">
+  <Component FQDD="NIC.Integrated.1-1-1"><Attribute Name="LegacyBootProto">PXE</Attribute></Component>
+  <Component FQDD="BIOS.Setup.1-1">
+    <Attribute Name="SysProfile">PerfOptimized</Attribute>
+    <Attribute Name="BootMode">Uefi</Attribute>
+  </Component>
+  <Component FQDD="iDRAC.Embedded.1">
+    <Attribute Name="Users.2#UserName">root</Attribute>
+    <Attribute Name="Users.2#Password">calvin</Attribute>
+    <Attribute Name="SNMP.1#AgentCommunity">public</Attribute>
+  </Component>
+  <Component FQDD="RAID.Integrated.1-1">
+    <Component FQDD="Disk.Bay.0:Enclosure.Internal.0-1:RAID.Integrated.1-1">
+      <Attribute Name="RAIDPDState">Ready</Attribute>
+    </Component>
+    <Attribute Name="RAIDresetConfig">False</Attribute>
+  </Component>
+</SystemConfiguration>`
+	assert.True(t, EquivalentSCP(testSCP, reordered))
+	assert.False(t, EquivalentSCP(testSCP, strings.Replace(reordered, "Uefi", "Bios", 1)))
+	assert.False(t, EquivalentSCP(testSCP, "NA"))
+	assert.True(t, EquivalentSCP("NA", "NA"))
+}
+
+func TestLintSCP(t *testing.T) {
+	issues, err := LintSCP(testSCP)
+	assert.Nil(t, err)
+	assert.Empty(t, issues)
+
+	_, err = LintSCP(`<SystemConfiguration><Component FQDD="BIOS.Setup.1-1"></SystemConfiguration>`)
+	assert.NotNil(t, err)
+
+	issues, err = LintSCP(`<SystemConfiguration>
+<Component FQDD="BIOS.Setup.1-1">
+<Attribute Name="BootMode">Uefi</Attribute>
+<Attribute Name="BootMode">Bios</Attribute>
+<Attribute>Bios</Attribute>
+</Component>
+<Component FQDD="BIOS.Setup.1-1"></Component>
+<Component FQDD="RAID.Integrated.1-1">
+<Component FQDD="Disk.Bay.0:Enclosure.Internal.0-1:RAID.Slot.3-1"></Component>
+</Component>
+<Component FQDD="NIC..1"></Component>
+<Component></Component>
+</SystemConfiguration>`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"line 4, component BIOS.Setup.1-1, attribute BootMode: duplicate attribute, first defined on line 3",
+		"line 5, component BIOS.Setup.1-1: attribute has no name",
+		"line 7, component BIOS.Setup.1-1: duplicate component, first defined on line 2",
+		"line 9, component Disk.Bay.0:Enclosure.Internal.0-1:RAID.Slot.3-1: FQDD does not belong to its parent component RAID.Integrated.1-1",
+		"line 11, component NIC..1: FQDD is not well formed",
+		"line 12: component has no FQDD",
+	}, scpIssueStrings(issues))
+
+	issues, err = LintSCP(`<Configuration><Attribute Name="BootMode">Uefi</Attribute></Configuration>`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"line 1: root element is Configuration instead of SystemConfiguration",
+		"line 1, attribute BootMode: attribute is not defined in a component",
+		"line 1: no component is defined",
+	}, scpIssueStrings(issues))
+}
+
+func scpIssueStrings(issues []SCPIssue) []string {
+	messages := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		messages = append(messages, issue.String())
+	}
+	return messages
+}